@@ -0,0 +1,22 @@
+provider "fastly" {
+  no_auth = true
+}
+
+data "fastly_logging_format_preview" "json" {
+  format = file("${path.module}/log_format.json")
+
+  variables = {
+    "client.ip"     = "192.0.2.1"
+    "req.http.host" = "example.com"
+    "req.url"       = "/index.html"
+    "resp.status"   = "200"
+  }
+}
+
+output "rendered" {
+  value = data.fastly_logging_format_preview.json.rendered
+}
+
+output "valid_json" {
+  value = data.fastly_logging_format_preview.json.valid_json
+}
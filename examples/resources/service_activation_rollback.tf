@@ -0,0 +1,21 @@
+resource "fastly_service_vcl" "demo" {
+  name = "demofastly"
+
+  domain {
+    name    = "demo.notexample.com"
+    comment = "demo"
+  }
+
+  backend {
+    address = "127.0.0.1"
+    name    = "localhost"
+  }
+
+  force_destroy = true
+}
+
+# Roll back to a previous known-good version by setting its number and applying.
+resource "fastly_service_activation" "demo" {
+  service_id = fastly_service_vcl.demo.id
+  version    = 3
+}
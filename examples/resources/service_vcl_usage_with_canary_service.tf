@@ -0,0 +1,49 @@
+variable "promote" {
+  type    = bool
+  default = false
+}
+
+locals {
+  backend_address = "origin.notexample.com"
+}
+
+# The canary service always activates the latest configuration on its own domain.
+resource "fastly_service_vcl" "canary" {
+  name = "demofastly-canary"
+
+  domain {
+    name = "canary.notexample.com"
+  }
+
+  backend {
+    address = local.backend_address
+    name    = "origin"
+    port    = 443
+    use_ssl = true
+  }
+
+  force_destroy = true
+}
+
+# The production service only drafts the same configuration until it is promoted.
+resource "fastly_service_vcl" "production" {
+  name = "demofastly"
+
+  domain {
+    name = "demo.notexample.com"
+  }
+
+  backend {
+    address = local.backend_address
+    name    = "origin"
+    port    = 443
+    use_ssl = true
+  }
+
+  activate      = var.promote
+  force_destroy = true
+}
+
+output "production_draft_version" {
+  value = fastly_service_vcl.production.cloned_version
+}
\ No newline at end of file
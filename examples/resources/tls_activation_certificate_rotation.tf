@@ -0,0 +1,18 @@
+resource "fastly_tls_certificate" "old" {
+  certificate_body = "..."
+  name             = "demo-cert-2021"
+  depends_on       = [fastly_tls_private_key.demo]
+}
+
+resource "fastly_tls_certificate" "new" {
+  certificate_body = "..."
+  name             = "demo-cert-2022"
+  depends_on       = [fastly_tls_private_key.demo]
+}
+
+resource "fastly_tls_activation" "test" {
+  # Previously fastly_tls_certificate.old.id
+  certificate_id = fastly_tls_certificate.new.id
+  domain         = "example.com"
+  depends_on     = [fastly_service_vcl.demo]
+}
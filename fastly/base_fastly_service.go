@@ -12,11 +12,18 @@ import (
 	gofastly "github.com/fastly/go-fastly/v6/fastly"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 var fastlyNoServiceFoundErr = errors.New("No matching Fastly Service found")
 
+// serviceDeactivationTimeout bounds how long deleting a service waits for its active version to be deactivated.
+const serviceDeactivationTimeout = 5 * time.Minute
+
+// serviceDeleteTimeout is the default time allowed for deleting a service, including any drain_timeout.
+const serviceDeleteTimeout = 20 * time.Minute
+
 const (
 	// ServiceTypeVCL is the type for VCL services.
 	ServiceTypeVCL = "vcl"
@@ -49,6 +56,14 @@ func (d *BaseServiceDefinition) GetAttributeHandler() []ServiceAttributeDefiniti
 	return d.Attributes
 }
 
+// serviceResourceName returns the name of the resource that manages services of the given type.
+func serviceResourceName(serviceType string) string {
+	if serviceType == ServiceTypeCompute {
+		return "fastly_service_compute"
+	}
+	return "fastly_service_vcl"
+}
+
 // resourceService returns a Terraform resource schema for VCL or Compute.
 func resourceService(serviceDef ServiceDefinition) *schema.Resource {
 	s := &schema.Resource{
@@ -57,13 +72,54 @@ func resourceService(serviceDef ServiceDefinition) *schema.Resource {
 		UpdateContext: resourceUpdate(serviceDef),
 		DeleteContext: resourceDelete(serviceDef),
 		Importer:      resourceImport(),
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(serviceDeleteTimeout),
+		},
 		CustomizeDiff: customdiff.All(
+			func(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+				return validateBackendHealthchecks(d.Get("backend").(*schema.Set), d.Get("healthcheck").(*schema.Set))
+			},
+			func(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+				return validateDirectorBackends(d.Get("director").(*schema.Set), d.Get("backend").(*schema.Set))
+			},
+			func(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+				if serviceDef.GetType() != ServiceTypeVCL {
+					return nil
+				}
+				return validateSnippetNames(d.Get("snippet").(*schema.Set), d.Get("dynamicsnippet").(*schema.Set))
+			},
+			func(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+				return validateServiceConditionReferences(serviceDef, d.Get)
+			},
+			func(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+				if serviceDef.GetType() != ServiceTypeVCL {
+					return nil
+				}
+				return validateWAFResponseObject(d.Get("waf").([]interface{}), d.Get("response_object").(*schema.Set))
+			},
+			func(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+				if serviceDef.GetType() != ServiceTypeVCL {
+					return nil
+				}
+				return validateHeaderSources(d.Get("header").(*schema.Set))
+			},
+			func(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+				var acls *schema.Set
+				if serviceDef.GetType() == ServiceTypeVCL {
+					acls = d.Get("acl").(*schema.Set)
+				}
+				return validateDictionaryNames(d.Get("dictionary").(*schema.Set), acls)
+			},
+			validateLoggingEndpoints("logging_kafka", checkKafkaAuth),
+			validateLoggingEndpoints("logging_kafka", checkKafkaTLSClient),
+			validateLoggingEndpoints("logging_sftp", checkSFTPAuth),
+			validateLoggingEndpoints("logging_kinesis", checkKinesisAuth),
 			customdiff.ComputedIf("cloned_version", func(_ context.Context, d *schema.ResourceDiff, _ interface{}) bool {
-				// If anything other than name, comment and version_comment has changed, the current version will be
-				// cloned in resourceServiceUpdate so set it as recomputed. These three fields can be updated without
-				// creating a new version
+				// If anything other than name, comment, version_comment and drain_timeout has changed, the current
+				// version will be cloned in resourceServiceUpdate so set it as recomputed. These fields can be updated
+				// without creating a new version
 				for _, changedKey := range d.GetChangedKeysPrefix("") {
-					if changedKey == "name" || changedKey == "comment" || changedKey == "version_comment" {
+					if changedKey == "name" || changedKey == "comment" || changedKey == "version_comment" || changedKey == "drain_timeout" {
 						continue
 					}
 					return true
@@ -81,20 +137,22 @@ func resourceService(serviceDef ServiceDefinition) *schema.Resource {
 			"name": {
 				Type:        schema.TypeString,
 				Required:    true,
-				Description: "The unique name for the Service to create",
+				Description: "The unique name for the Service to create. Changing the name renames the existing service in place",
 			},
 
 			"comment": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Default:     "Managed by Terraform",
-				Description: "Description field for the service. Default `Managed by Terraform`",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "Managed by Terraform",
+				Description:      fmt.Sprintf("Description field for the service. Must be at most %d characters. Default `Managed by Terraform`", serviceCommentMaxLength),
+				ValidateDiagFunc: validateServiceComment(),
 			},
 
 			"version_comment": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "Description field for the version",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Description:      fmt.Sprintf("Description field for the version. Must be at most %d characters. If the current version is locked, changing only this field clones it into a new version, which is activated unless `activate` is `false`", serviceCommentMaxLength),
+				ValidateDiagFunc: validateServiceComment(),
 			},
 
 			// Active Version represents the currently activated version in Fastly. In
@@ -115,7 +173,7 @@ func resourceService(serviceDef ServiceDefinition) *schema.Resource {
 			"cloned_version": {
 				Type:        schema.TypeInt,
 				Computed:    true,
-				Description: "The latest cloned version by the provider",
+				Description: "The latest cloned version by the provider. When `activate` is `false`, this is the drafted version which has not been activated",
 			},
 
 			"activate": {
@@ -130,9 +188,27 @@ func resourceService(serviceDef ServiceDefinition) *schema.Resource {
 				Optional:    true,
 				Description: "Services that are active cannot be destroyed. In order to destroy the Service, set `force_destroy` to `true`. Default `false`",
 			},
+
+			"drain_timeout": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Default:          0,
+				Description:      "How long, in seconds, to keep the active version serving traffic when the Service is destroyed with `force_destroy`, before deactivating it. Useful when clients may still resolve to the Service, e.g. until DNS records moved to another Service expire. The drain counts towards the `delete` timeout, which must leave at least 5 minutes to deactivate the version. Default `0`",
+				ValidateDiagFunc: validateServiceDrainTimeout(),
+			},
 		},
 	}
 
+	// Placement only applies to the logging endpoints of VCL services.
+	if serviceDef.GetType() == ServiceTypeVCL {
+		s.Schema[defaultLoggingPlacementKey] = &schema.Schema{
+			Type:             schema.TypeString,
+			Optional:         true,
+			Description:      "The placement used by logging endpoints that don't set their own `placement`, e.g. `none` to opt every endpoint out of the default logging call. A `placement` set on a logging endpoint overrides it",
+			ValidateDiagFunc: validateLoggingPlacement(),
+		}
+	}
+
 	// This loops over all the attribute handlers in the service definition and calls Register.
 	// Register adds schema attributes to the overall schema for the resource. This allows each AttributeHandler to
 	// define its own attributes while allowing the overall set to be composed.
@@ -187,6 +263,11 @@ func resourceImport() *schema.ResourceImporter {
 			id := parts[0]
 			d.SetId(id)
 
+			// drain_timeout only exists in the configuration, so it is set to its default.
+			if err := d.Set("drain_timeout", 0); err != nil {
+				return nil, err
+			}
+
 			if len(parts) == 2 {
 				version, err := strconv.Atoi(parts[1])
 				if err != nil {
@@ -230,6 +311,7 @@ func resourceServiceCreate(ctx context.Context, d *schema.ResourceData, meta int
 		return diag.FromErr(err)
 	}
 
+	// Update also applies the rest of the configuration and reports its serviceWarnings.
 	return resourceServiceUpdate(ctx, d, meta, serviceDef)
 }
 
@@ -278,7 +360,14 @@ func resourceServiceUpdate(ctx context.Context, d *schema.ResourceData, meta int
 		log.Printf("[DEBUG] Update Version opts: %#v", opts)
 		_, err := conn.UpdateVersion(&opts)
 		if err != nil {
-			return diag.FromErr(err)
+			// A locked version can't be modified, but it can be cloned, so fall
+			// back to setting the comment on a new version instead.
+			locked, lerr := isVersionLocked(conn, d.Id(), opts.ServiceVersion)
+			if lerr != nil || !locked || d.IsNewResource() {
+				return diag.FromErr(err)
+			}
+			log.Printf("[DEBUG] Version (%d) is locked, cloning it to update the version comment", opts.ServiceVersion)
+			needsChange = true
 		}
 	}
 
@@ -393,7 +482,62 @@ func resourceServiceUpdate(ctx context.Context, d *schema.ResourceData, meta int
 		log.Printf("[INFO] Visit https://manage.fastly.com/configure/services/%s/versions/%v and activate it manually", d.Id(), latestVersion)
 	}
 
-	return resourceServiceRead(ctx, d, meta, serviceDef)
+	return append(serviceWarnings(d, serviceDef), resourceServiceRead(ctx, d, meta, serviceDef)...)
+}
+
+// serviceWarnings returns the warnings about the configuration of a service which Fastly accepts but which likely
+// don't behave as intended. They are reported once an apply succeeds, both when the service is created and when it is
+// updated, since the SDK can only return warnings from validation functions, which see a single attribute, while
+// these checks span several attributes or blocks. Only changed blocks are checked, so a warning isn't repeated on
+// every apply.
+func serviceWarnings(d *schema.ResourceData, serviceDef ServiceDefinition) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if serviceDef.GetType() == ServiceTypeVCL && d.HasChanges("response_object", "gzip") {
+		diags = append(diags, responseObjectGzipWarnings(d)...)
+	}
+	if serviceDef.GetType() == ServiceTypeVCL && d.HasChange("header") {
+		diags = append(diags, headerPriorityWarnings(d)...)
+	}
+	if d.HasChange("backend") {
+		diags = append(diags, backendPlaintextWarnings(d)...)
+		diags = append(diags, backendTimeoutWarnings(d)...)
+		diags = append(diags, backendIPTLSWarnings(d)...)
+	}
+	if serviceDef.GetType() == ServiceTypeVCL && d.HasChanges("backend", "director") {
+		diags = append(diags, backendWeightWarnings(d)...)
+		diags = append(diags, backendShieldWarnings(d)...)
+	}
+	if d.HasChange("logging_s3") {
+		diags = append(diags, s3GzipPeriodWarnings(d)...)
+	}
+	if serviceDef.GetType() == ServiceTypeVCL {
+		diags = append(diags, loggingPlacementWarnings(d, serviceDef)...)
+	}
+	return diags
+}
+
+// serviceVersionComment returns the comment of the given version of a service,
+// falling back to the comment of the active version if the version isn't listed.
+func serviceVersionComment(s *gofastly.ServiceDetail, number int) string {
+	for _, v := range s.Versions {
+		if v.Number == number {
+			return v.Comment
+		}
+	}
+	return s.ActiveVersion.Comment
+}
+
+// isVersionLocked reports whether the given service version is locked. Locked
+// versions are immutable, but can still be cloned.
+func isVersionLocked(conn *gofastly.Client, serviceID string, serviceVersion int) (bool, error) {
+	version, err := conn.GetVersion(&gofastly.GetVersionInput{
+		ServiceID:      serviceID,
+		ServiceVersion: serviceVersion,
+	})
+	if err != nil {
+		return false, fmt.Errorf("[ERR] Error looking up version (%d) for Fastly Service (%s): %s", serviceVersion, serviceID, err)
+	}
+	return version.Locked, nil
 }
 
 // resourceServiceRead provides service resource Read functionality.
@@ -423,7 +567,7 @@ func resourceServiceRead(ctx context.Context, d *schema.ResourceData, meta inter
 
 	// Check for service type mismatch (i.e. when importing)
 	if s.Type != serviceDef.GetType() {
-		return diag.Errorf("[ERR] Service type mismatch in READ, expected: %s, got: %s", serviceDef.GetType(), s.Type)
+		return diag.Errorf("[ERR] Service type mismatch in READ, expected: %s, got: %s. Use the %s resource to manage service (%s)", serviceDef.GetType(), s.Type, serviceResourceName(s.Type), d.Id())
 	}
 
 	err = d.Set("name", s.Name)
@@ -434,10 +578,6 @@ func resourceServiceRead(ctx context.Context, d *schema.ResourceData, meta inter
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	err = d.Set("version_comment", s.ActiveVersion.Comment)
-	if err != nil {
-		return diag.FromErr(err)
-	}
 	err = d.Set("active_version", s.ActiveVersion.Number)
 	if err != nil {
 		return diag.FromErr(err)
@@ -489,6 +629,13 @@ func resourceServiceRead(ctx context.Context, d *schema.ResourceData, meta inter
 		}
 	}
 
+	// version_comment belongs to the version being read, which is the drafted
+	// version rather than the active one when activate is false.
+	err = d.Set("version_comment", serviceVersionComment(s, s.ActiveVersion.Number))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	// If CreateService succeeds, but initial updates to the Service fail, we'll
 	// have an empty ActiveService version (no version is active, so we can't
 	// query for information on it).
@@ -514,16 +661,51 @@ func resourceServiceRead(ctx context.Context, d *schema.ResourceData, meta inter
 		log.Printf("[DEBUG] Active Version for Service (%s) is empty, no state to refresh", d.Id())
 	}
 
+	if meta.(*FastlyClient).warnLoggingFormatVersion1 {
+		diags = append(diags, loggingFormatVersionWarnings(d, serviceDef)...)
+	}
+
+	return diags
+}
+
+// loggingFormatVersionWarnings returns a warning for every logging endpoint of the service that still uses
+// format_version 1.
+func loggingFormatVersionWarnings(d *schema.ResourceData, serviceDef ServiceDefinition) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for _, a := range serviceDef.GetAttributeHandler() {
+		h, ok := a.(*blockSetAttributeHandler)
+		if !ok || !strings.HasPrefix(h.handler.Key(), "logging_") {
+			continue
+		}
+
+		set, ok := d.Get(h.handler.Key()).(*schema.Set)
+		if !ok {
+			continue
+		}
+
+		for _, elem := range set.List() {
+			m := elem.(map[string]interface{})
+			if v, ok := m["format_version"].(int); ok && v == 1 {
+				diags = append(diags, diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  "Logging endpoint uses format_version 1",
+					Detail:   fmt.Sprintf("'%s' endpoint '%s' of service (%s) uses 'format_version = 1'. Migrate it to 'format_version = 2', see https://docs.fastly.com/en/guides/custom-log-formats#version-2-log-format", h.handler.Key(), m["name"], d.Id()),
+				})
+			}
+		}
+	}
+
 	return diags
 }
 
 // resourceServiceDelete provides service resource Delete functionality.
-func resourceServiceDelete(_ context.Context, d *schema.ResourceData, meta interface{}, _ ServiceDefinition) diag.Diagnostics {
+func resourceServiceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}, _ ServiceDefinition) diag.Diagnostics {
 	conn := meta.(*FastlyClient).conn
 
 	// Fastly will fail to delete any service with an Active Version.
-	// If `force_destroy` is given, we deactivate the active version and then send
-	// the DELETE call.
+	// If `force_destroy` is given, we deactivate the active version, wait for the
+	// service to report no active version and then send the DELETE call.
 	if d.Get("force_destroy").(bool) {
 		s, err := conn.GetServiceDetails(&gofastly.GetServiceInput{
 			ID: d.Id(),
@@ -534,6 +716,10 @@ func resourceServiceDelete(_ context.Context, d *schema.ResourceData, meta inter
 		}
 
 		if s.ActiveVersion.Number != 0 {
+			if err := drainService(ctx, d); err != nil {
+				return diag.FromErr(err)
+			}
+
 			_, err := conn.DeactivateVersion(&gofastly.DeactivateVersionInput{
 				ServiceID:      d.Id(),
 				ServiceVersion: s.ActiveVersion.Number,
@@ -541,6 +727,10 @@ func resourceServiceDelete(_ context.Context, d *schema.ResourceData, meta inter
 			if err != nil {
 				return diag.FromErr(err)
 			}
+
+			if err := waitForServiceDeactivation(ctx, conn, d.Id()); err != nil {
+				return diag.FromErr(err)
+			}
 		}
 	}
 
@@ -553,3 +743,44 @@ func resourceServiceDelete(_ context.Context, d *schema.ResourceData, meta inter
 
 	return nil
 }
+
+// waitForServiceDeactivation polls a service until it no longer reports an active version. The deactivation isn't
+// always visible straight away, and deleting the service before it is fails because the service has an active version.
+func waitForServiceDeactivation(ctx context.Context, conn *gofastly.Client, serviceID string) error {
+	return resource.RetryContext(ctx, serviceDeactivationTimeout, func() *resource.RetryError {
+		s, err := conn.GetServiceDetails(&gofastly.GetServiceInput{
+			ID: serviceID,
+		})
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		if s.ActiveVersion.Number != 0 {
+			return resource.RetryableError(fmt.Errorf("Service (%s) still has an active version (%d)", serviceID, s.ActiveVersion.Number))
+		}
+		return nil
+	})
+}
+
+// drainService waits for the configured drain_timeout before the active version of a service is deactivated, so
+// that clients still resolving to the service keep being served in the meantime.
+func drainService(ctx context.Context, d *schema.ResourceData) error {
+	timeout := time.Duration(d.Get("drain_timeout").(int)) * time.Second
+	if timeout <= 0 {
+		return nil
+	}
+
+	// Fail before draining when the rest of the delete wouldn't fit in the delete timeout.
+	if deadline, ok := ctx.Deadline(); ok {
+		if remaining := time.Until(deadline); timeout+serviceDeactivationTimeout > remaining {
+			return fmt.Errorf("drain_timeout (%s) doesn't leave %s of the delete timeout (%s remaining) to deactivate service (%s), increase the delete timeout", timeout, serviceDeactivationTimeout, remaining.Round(time.Second), d.Id())
+		}
+	}
+
+	log.Printf("[DEBUG] Draining service (%s) for %s before deactivating it", d.Id(), timeout)
+	select {
+	case <-time.After(timeout):
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("error draining service (%s) before deactivating it: %w", d.Id(), ctx.Err())
+	}
+}
@@ -33,7 +33,7 @@ func (h *ACLServiceAttributeHandler) GetSchema() *schema.Schema {
 				"name": {
 					Type:        schema.TypeString,
 					Required:    true,
-					Description: "A unique name to identify this ACL. It is important to note that changing this attribute will delete and recreate the ACL, and discard the current items in the ACL",
+					Description: "A unique name to identify this ACL. It is important to note that changing this attribute will delete and recreate the ACL, and discard the current items in the ACL, unless `renamed_from` is set",
 				},
 				// Optional fields
 				"acl_id": {
@@ -47,6 +47,11 @@ func (h *ACLServiceAttributeHandler) GetSchema() *schema.Schema {
 					Optional:    true,
 					Description: "Allow the ACL to be deleted, even if it contains entries. Defaults to false.",
 				},
+				"renamed_from": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "The previous `name` of the ACL. If the ACL with that name is removed by the same change, it is renamed in place and keeps its entries, rather than being deleted and recreated",
+				},
 			},
 		},
 	}
@@ -83,10 +88,13 @@ func (h *ACLServiceAttributeHandler) Read(_ context.Context, d *schema.ResourceD
 	// Match up force_destroy on each ACL from schema.ResourceData to avoid d.Set overwriting it with null
 	stateACLs := d.Get(h.Key()).(*schema.Set).List()
 	for _, acl := range al {
+		// Default to false when the ACL isn't in state yet, e.g. when importing a service.
+		acl["force_destroy"] = false
 		for _, sa := range stateACLs {
 			stateACL := sa.(map[string]interface{})
 			if acl["name"] == stateACL["name"] {
 				acl["force_destroy"] = stateACL["force_destroy"]
+				acl["renamed_from"] = stateACL["renamed_from"]
 				break
 			}
 		}
@@ -103,6 +111,30 @@ func (h *ACLServiceAttributeHandler) Update(context.Context, *schema.ResourceDat
 	return nil
 }
 
+// IsRename reports whether an ACL is renamed in place, which is only done
+// when the new ACL's renamed_from names the removed one.
+func (h *ACLServiceAttributeHandler) IsRename(oldResource, newResource map[string]interface{}) bool {
+	from, _ := newResource["renamed_from"].(string)
+	return from != "" && from == oldResource["name"]
+}
+
+func (h *ACLServiceAttributeHandler) Rename(_ context.Context, d *schema.ResourceData, oldResource, newResource map[string]interface{}, latestVersion int, conn *gofastly.Client) error {
+	opts := gofastly.UpdateACLInput{
+		ServiceID:      d.Id(),
+		ServiceVersion: latestVersion,
+		Name:           oldResource["name"].(string),
+		NewName:        newResource["name"].(string),
+	}
+
+	log.Printf("[DEBUG] Fastly ACL rename opts: %#v", opts)
+	_, err := conn.UpdateACL(&opts)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (h *ACLServiceAttributeHandler) Delete(_ context.Context, d *schema.ResourceData, resource map[string]interface{}, latestVersion int, conn *gofastly.Client) error {
 	if !resource["force_destroy"].(bool) {
 		mayDelete, err := isACLEmpty(d.Id(), resource["acl_id"].(string), conn)
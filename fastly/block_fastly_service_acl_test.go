@@ -41,6 +41,26 @@ func TestResourceFastlyFlattenAcl(t *testing.T) {
 	}
 }
 
+func TestResourceFastlyACLIsRename(t *testing.T) {
+	h := &ACLServiceAttributeHandler{}
+	blocklist := map[string]interface{}{"name": "blocklist", "acl_id": "1234567890", "renamed_from": ""}
+	for _, c := range []struct {
+		name     string
+		acl      map[string]interface{}
+		expected bool
+	}{
+		{name: "renamed_from set", acl: map[string]interface{}{"name": "denylist", "renamed_from": "blocklist"}, expected: true},
+		{name: "unrelated ACL", acl: map[string]interface{}{"name": "allowlist", "renamed_from": ""}, expected: false},
+		{name: "renamed_from names another ACL", acl: map[string]interface{}{"name": "allowlist", "renamed_from": "others"}, expected: false},
+	} {
+		t.Run(c.name, func(t *testing.T) {
+			if got := h.IsRename(blocklist, c.acl); got != c.expected {
+				t.Fatalf("expected rename %t, got %t", c.expected, got)
+			}
+		})
+	}
+}
+
 func TestAccFastlyServiceVCL_acl(t *testing.T) {
 	var service gofastly.ServiceDetail
 	var aclA gofastly.ACL
@@ -50,13 +70,14 @@ func TestAccFastlyServiceVCL_acl(t *testing.T) {
 	aclName := fmt.Sprintf("acl_%s", acctest.RandString(10))
 	aclNameUpdated := fmt.Sprintf("acl_updated_%s", acctest.RandString(10))
 
-	// Six part test:
+	// Seven part test:
 	// 1. Create service with 2 ACLs
-	// 2. Rename both the ACLs, should succeed because the ACLs are empty
-	// 3. Keep both ACLs the same and add an entry
-	// 4. Try to rename the ACLs, expect to fail with "list not empty error"
-	// 5. Without renaming the ACLs, set force_destroy=true to skip the deletion check
-	// 6. Try to rename the ACLs again, expect to succeed
+	// 2. Import the service, expect the ACLs to match the config
+	// 3. Rename both the ACLs, should succeed because the ACLs are empty
+	// 4. Keep both ACLs the same and add an entry
+	// 5. Try to rename the ACLs, expect to fail with "list not empty error"
+	// 6. Without renaming the ACLs, set force_destroy=true to skip the deletion check
+	// 7. Try to rename the ACLs again, expect to succeed
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { testAccPreCheck(t) },
 		ProviderFactories: testAccProviders,
@@ -70,6 +91,13 @@ func TestAccFastlyServiceVCL_acl(t *testing.T) {
 					testAccCheckFastlyServiceVCLAttributes_acl(&service, name, "b_"+aclName, &aclB),
 				),
 			},
+			{
+				ResourceName:      "fastly_service_vcl.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// These attributes are not stored on the Fastly API and must be ignored.
+				ImportStateVerifyIgnore: []string{"activate", "force_destroy"},
+			},
 			{
 				Config: testAccServiceVCLConfig_acl(name, aclNameUpdated, domain),
 				Check: resource.ComposeTestCheckFunc(
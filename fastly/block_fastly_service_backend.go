@@ -4,11 +4,28 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"net"
+	"sort"
+	"strings"
 
 	gofastly "github.com/fastly/go-fastly/v6/fastly"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
+// backendPrivateNetworks are the address ranges which plaintext backends are expected to use.
+var backendPrivateNetworks = []string{
+	"10.0.0.0/8",
+	"172.16.0.0/12",
+	"192.168.0.0/16",
+	"127.0.0.0/8",
+	"169.254.0.0/16",
+	"100.64.0.0/10",
+	"::1/128",
+	"fc00::/7",
+	"fe80::/10",
+}
+
 type BackendServiceAttributeHandler struct {
 	*DefaultServiceAttributeHandler
 }
@@ -50,7 +67,7 @@ func (h *BackendServiceAttributeHandler) GetSchema() *schema.Schema {
 			Type:        schema.TypeInt,
 			Optional:    true,
 			Default:     10000,
-			Description: "How long to wait between bytes in milliseconds. Default `10000`",
+			Description: "How long to wait between bytes in milliseconds. A value shorter than `connect_timeout` is reported as a warning on apply. Default `10000`",
 		},
 		"connect_timeout": {
 			Type:        schema.TypeInt,
@@ -68,7 +85,7 @@ func (h *BackendServiceAttributeHandler) GetSchema() *schema.Schema {
 			Type:        schema.TypeInt,
 			Optional:    true,
 			Default:     15000,
-			Description: "How long to wait for the first bytes in milliseconds. Default `15000`",
+			Description: "How long to wait for the first bytes in milliseconds. A value shorter than `connect_timeout` is reported as a warning on apply. Default `15000`",
 		},
 		"healthcheck": {
 			Type:        schema.TypeString,
@@ -104,7 +121,7 @@ func (h *BackendServiceAttributeHandler) GetSchema() *schema.Schema {
 			Type:        schema.TypeBool,
 			Optional:    true,
 			Default:     false,
-			Description: "Whether or not to use SSL to reach the Backend. Default `false`",
+			Description: "Whether or not to use SSL to reach the Backend. Reaching a public address without SSL is reported as a warning on apply. Default `false`",
 		},
 		"max_tls_version": {
 			Type:        schema.TypeString,
@@ -138,16 +155,18 @@ func (h *BackendServiceAttributeHandler) GetSchema() *schema.Schema {
 			Deprecated:  "Use ssl_cert_hostname and ssl_sni_hostname instead.",
 		},
 		"ssl_ca_cert": {
-			Type:        schema.TypeString,
-			Optional:    true,
-			Default:     "",
-			Description: "CA certificate attached to origin.",
+			Type:             schema.TypeString,
+			Optional:         true,
+			Default:          "",
+			Description:      "CA certificate attached to origin, in PEM format. Set this when the origin's certificate is signed by a private CA, so that the certificate can still be verified",
+			ValidateDiagFunc: validateBackendSSLCACert(),
 		},
 		"ssl_cert_hostname": {
-			Type:        schema.TypeString,
-			Optional:    true,
-			Default:     "",
-			Description: "Overrides ssl_hostname, but only for cert verification. Does not affect SNI at all",
+			Type:             schema.TypeString,
+			Optional:         true,
+			Default:          "",
+			Description:      "Overrides ssl_hostname, but only for cert verification. Does not affect SNI at all. The value is used verbatim and should be the specific hostname the origin certificate covers: a wildcard certificate for `*.example.com` matches `www.example.com`, but neither `example.com` nor `a.b.example.com`. Leaving it unset for a backend addressed by IP with `use_ssl` is reported as a warning on apply",
+			ValidateDiagFunc: validateSSLCertHostname(),
 		},
 		"ssl_sni_hostname": {
 			Type:        schema.TypeString,
@@ -173,7 +192,7 @@ func (h *BackendServiceAttributeHandler) GetSchema() *schema.Schema {
 			Type:        schema.TypeInt,
 			Optional:    true,
 			Default:     100,
-			Description: "The [portion of traffic](https://docs.fastly.com/en/guides/load-balancing-configuration#how-weight-affects-load-balancing) to send to this Backend. Each Backend receives weight / total of the traffic. Default `100`",
+			Description: "The [portion of traffic](https://docs.fastly.com/en/guides/load-balancing-configuration#how-weight-affects-load-balancing) to send to this Backend. Each Backend receives weight / total of the traffic. Only has an effect on backends used by a `director`. Default `100`",
 		},
 	}
 
@@ -189,6 +208,7 @@ func (h *BackendServiceAttributeHandler) GetSchema() *schema.Schema {
 			Default:     "",
 			Description: "Name of a condition, which if met, will select this backend during a request.",
 		}
+		blockAttributes["weight"].Description = "The [portion of traffic](https://docs.fastly.com/en/guides/load-balancing-configuration#how-weight-affects-load-balancing) to send to this Backend. Each Backend receives weight / total of the traffic. Only has an effect on backends used by a `director`, and a weight other than the default on any other backend is reported as a warning on apply. Default `100`"
 	}
 
 	return &schema.Schema{
@@ -435,3 +455,238 @@ func flattenBackend(backendList []*gofastly.Backend, sa ServiceMetadata) []map[s
 	}
 	return bl
 }
+
+// backendPlaintextWarnings returns a warning for every backend reaching a public address over plaintext HTTP, which is
+// usually a mistake for production traffic. Only backends that were added or changed are checked, so the warning isn't
+// repeated on every apply.
+func backendPlaintextWarnings(d *schema.ResourceData) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	o, n := d.GetChange("backend")
+	backends, ok := n.(*schema.Set)
+	if !ok {
+		return diags
+	}
+	if old, ok := o.(*schema.Set); ok {
+		backends = backends.Difference(old)
+	}
+
+	for _, elem := range backends.List() {
+		m := elem.(map[string]interface{})
+		if useSSL, _ := m["use_ssl"].(bool); useSSL {
+			continue
+		}
+		if port, _ := m["port"].(int); port != 0 && port != 80 {
+			continue
+		}
+		address, _ := m["address"].(string)
+		if isPrivateBackendAddress(address) {
+			continue
+		}
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "Backend uses plaintext to a public address",
+			Detail:   fmt.Sprintf("Backend '%s' connects to '%s' over plaintext HTTP. Traffic to this origin is not encrypted; consider setting use_ssl = true and port = 443", m["name"], address),
+		})
+	}
+
+	return diags
+}
+
+// backendIPTLSWarnings returns a warning for every backend reaching an IP address over TLS, with certificate checks
+// enabled, but without a hostname to verify the certificate against. Origin certificates are issued for hostnames, so
+// verifying them against the IP address fails.
+func backendIPTLSWarnings(d *schema.ResourceData) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	backends, ok := d.Get("backend").(*schema.Set)
+	if !ok {
+		return diags
+	}
+
+	for _, elem := range backends.List() {
+		m := elem.(map[string]interface{})
+		if useSSL, _ := m["use_ssl"].(bool); !useSSL {
+			continue
+		}
+		if checkCert, _ := m["ssl_check_cert"].(bool); !checkCert {
+			continue
+		}
+		address, _ := m["address"].(string)
+		if net.ParseIP(address) == nil {
+			continue
+		}
+		if m["ssl_cert_hostname"] != "" || m["ssl_sni_hostname"] != "" || m["ssl_hostname"] != "" {
+			continue
+		}
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "Backend uses TLS to an IP address without a hostname",
+			Detail:   fmt.Sprintf("Backend '%s' connects to the IP address '%s' over TLS without ssl_cert_hostname or ssl_sni_hostname set. Certificate verification will fail unless the origin's certificate covers the IP address; set ssl_cert_hostname and ssl_sni_hostname to the hostname the certificate is issued for", m["name"], address),
+		})
+	}
+
+	return diags
+}
+
+// backendWeightWarnings returns a warning for every backend with a non-default weight that isn't used by any director.
+// The weight only affects load balancing between the backends of a director, so it is ignored everywhere else.
+func backendWeightWarnings(d *schema.ResourceData) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	backends, ok := d.Get("backend").(*schema.Set)
+	if !ok {
+		return diags
+	}
+
+	directed := make(map[string]bool)
+	if directors, ok := d.Get("director").(*schema.Set); ok {
+		for _, elem := range directors.List() {
+			m := elem.(map[string]interface{})
+			if names, ok := m["backends"].(*schema.Set); ok {
+				for _, name := range names.List() {
+					directed[name.(string)] = true
+				}
+			}
+		}
+	}
+
+	for _, elem := range backends.List() {
+		m := elem.(map[string]interface{})
+		if weight, _ := m["weight"].(int); weight == 100 {
+			continue
+		}
+		name, _ := m["name"].(string)
+		if directed[name] {
+			continue
+		}
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "Backend weight has no effect",
+			Detail:   fmt.Sprintf("Backend '%s' sets weight = %d but isn't used by any director. The weight only affects load balancing between the backends of a director", name, m["weight"]),
+		})
+	}
+
+	return diags
+}
+
+// backendShieldWarnings returns a warning for every director whose backends shield through a different POP than the
+// director, or through different POPs from each other. Requests balanced between such backends reach the origin
+// through different shields, which splits the shield cache and adds a hop through a POP that may be far from the
+// origin. Whether a shield POP suits an origin can't be known from the config, so only these mismatches are flagged.
+func backendShieldWarnings(d *schema.ResourceData) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	backends, ok := d.Get("backend").(*schema.Set)
+	if !ok {
+		return diags
+	}
+	directors, ok := d.Get("director").(*schema.Set)
+	if !ok {
+		return diags
+	}
+
+	shields := make(map[string]string)
+	for _, elem := range backends.List() {
+		m := elem.(map[string]interface{})
+		name, _ := m["name"].(string)
+		shields[name], _ = m["shield"].(string)
+	}
+
+	for _, elem := range directors.List() {
+		m := elem.(map[string]interface{})
+		director, _ := m["name"].(string)
+		shield, _ := m["shield"].(string)
+		names, ok := m["backends"].(*schema.Set)
+		if !ok {
+			continue
+		}
+
+		pops := make(map[string][]string)
+		for _, name := range names.List() {
+			if pop := shields[name.(string)]; pop != "" {
+				pops[pop] = append(pops[pop], name.(string))
+			}
+		}
+
+		var keys []string
+		for pop := range pops {
+			keys = append(keys, pop)
+		}
+		sort.Strings(keys)
+
+		switch {
+		case shield != "":
+			for _, pop := range keys {
+				if pop == shield {
+					continue
+				}
+				sort.Strings(pops[pop])
+				diags = append(diags, diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  "Backend shield differs from its director",
+					Detail:   fmt.Sprintf("Backends %s of director '%s' shield through '%s', but the director shields through '%s'. Use the same shield POP for a director and its backends", strings.Join(pops[pop], ", "), director, pop, shield),
+				})
+			}
+		case len(keys) > 1:
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  "Director backends use different shields",
+				Detail:   fmt.Sprintf("The backends of director '%s' shield through different POPs (%s), so requests balanced between them reach the origin through different shields. Use the same shield POP for all of them, or set shield on the director", director, strings.Join(keys, ", ")),
+			})
+		}
+	}
+
+	return diags
+}
+
+// backendTimeoutWarnings returns a warning for every backend whose first_byte_timeout or between_bytes_timeout is
+// shorter than its connect_timeout. Waiting less for the response than for the connection is rarely intended, and
+// usually shows up as origins, e.g. slow or streaming ones, being disconnected mid-response.
+func backendTimeoutWarnings(d *schema.ResourceData) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	backends, ok := d.Get("backend").(*schema.Set)
+	if !ok {
+		return diags
+	}
+
+	for _, elem := range backends.List() {
+		m := elem.(map[string]interface{})
+		name, _ := m["name"].(string)
+		connect, _ := m["connect_timeout"].(int)
+
+		for _, k := range []string{"first_byte_timeout", "between_bytes_timeout"} {
+			if timeout, _ := m[k].(int); timeout < connect {
+				diags = append(diags, diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  "Backend timeout is shorter than connect_timeout",
+					Detail:   fmt.Sprintf("Backend '%s' sets %s = %d, which is shorter than connect_timeout = %d. Responses that take longer than %dms to start or between chunks will be disconnected", name, k, timeout, connect, timeout),
+				})
+			}
+		}
+	}
+
+	return diags
+}
+
+// isPrivateBackendAddress reports whether address is a loopback or private network address. Hostnames are assumed to
+// be public, as we don't resolve them.
+func isPrivateBackendAddress(address string) bool {
+	if strings.EqualFold(address, "localhost") {
+		return true
+	}
+
+	ip := net.ParseIP(address)
+	if ip == nil {
+		return false
+	}
+
+	for _, cidr := range backendPrivateNetworks {
+		_, network, err := net.ParseCIDR(cidr)
+		if err == nil && network.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
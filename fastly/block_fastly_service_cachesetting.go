@@ -38,9 +38,10 @@ func (h *CacheSettingServiceAttributeHandler) GetSchema() *schema.Schema {
 					Description: "Unique name for this Cache Setting. It is important to note that changing this attribute will delete and recreate the resource",
 				},
 				"action": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: `One of cache, pass, or restart, as defined on Fastly's documentation under "[Caching action descriptions](https://docs.fastly.com/en/guides/controlling-caching#caching-action-descriptions)"`,
+					Type:             schema.TypeString,
+					Optional:         true,
+					Description:      `One of cache, pass, or restart, as defined on Fastly's documentation under "[Caching action descriptions](https://docs.fastly.com/en/guides/controlling-caching#caching-action-descriptions)"`,
+					ValidateDiagFunc: validateCacheSettingAction(),
 				},
 				// optional
 				"cache_condition": {
@@ -50,14 +51,16 @@ func (h *CacheSettingServiceAttributeHandler) GetSchema() *schema.Schema {
 					Description: "Name of already defined `condition` used to test whether this settings object should be used. This `condition` must be of type `CACHE`",
 				},
 				"stale_ttl": {
-					Type:        schema.TypeInt,
-					Optional:    true,
-					Description: `Max "Time To Live" for stale (unreachable) objects`,
+					Type:             schema.TypeInt,
+					Optional:         true,
+					Description:      `Max "Time To Live" for stale (unreachable) objects`,
+					ValidateDiagFunc: validateTTL(),
 				},
 				"ttl": {
-					Type:        schema.TypeInt,
-					Optional:    true,
-					Description: "The Time-To-Live (TTL) for the object",
+					Type:             schema.TypeInt,
+					Optional:         true,
+					Description:      "The Time-To-Live (TTL) for the object",
+					ValidateDiagFunc: validateTTL(),
 				},
 			},
 		},
@@ -8,6 +8,7 @@ import (
 	gofastly "github.com/fastly/go-fastly/v6/fastly"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
@@ -244,3 +245,23 @@ resource "fastly_service_vcl" "foo" {
   force_destroy = true
 }`, name, domain)
 }
+
+func TestResourceFastlyFlattenCacheSettingsOrder(t *testing.T) {
+	remote := []*gofastly.CacheSetting{
+		{Name: "b_setting", Action: gofastly.CacheSettingActionPass, TTL: 300},
+		{Name: "a_setting", Action: gofastly.CacheSettingActionCache, StaleTTL: 3600},
+	}
+	reversed := []*gofastly.CacheSetting{remote[1], remote[0]}
+
+	// cache_setting is a set, so the order the API returns the settings in doesn't change what is saved to state.
+	state := func(settings []*gofastly.CacheSetting) *schema.Set {
+		d := schema.TestResourceDataRaw(t, resourceServiceVCL().Schema, map[string]interface{}{})
+		if err := d.Set("cache_setting", flattenCacheSettings(settings)); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		return d.Get("cache_setting").(*schema.Set)
+	}
+	if !state(remote).Equal(state(reversed)) {
+		t.Fatalf("Error matching: expected the same state regardless of the API order")
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"sort"
 	"strings"
 
 	gofastly "github.com/fastly/go-fastly/v6/fastly"
@@ -50,7 +51,7 @@ func (h *ConditionServiceAttributeHandler) GetSchema() *schema.Schema {
 				"type": {
 					Type:             schema.TypeString,
 					Required:         true,
-					Description:      "Type of condition, either `REQUEST` (req), `RESPONSE` (req, resp), or `CACHE` (req, beresp)",
+					Description:      "Type of condition, one of `REQUEST` (req), `CACHE` (req, beresp), `RESPONSE` (req, resp) or `PREFETCH` (req, bereq). There is no default, and the type must match where the condition is used: `REQUEST` conditions for `request_condition`, `CACHE` conditions for `cache_condition` and `RESPONSE` conditions for `response_condition`",
 					ValidateDiagFunc: validateConditionType(),
 				},
 			},
@@ -204,3 +205,113 @@ func flattenConditions(conditionList []*gofastly.Condition) []map[string]interfa
 
 	return cl
 }
+
+// conditionReferenceTypes maps the block attributes that reference a condition by name to the condition type required.
+var conditionReferenceTypes = map[string]string{
+	"request_condition":  "REQUEST",
+	"cache_condition":    "CACHE",
+	"response_condition": "RESPONSE",
+	"prefetch_condition": "PREFETCH",
+}
+
+// conditionReferenceAttributes returns, for every nested block of serviceDef with attributes that reference a
+// condition, those attributes mapped to the condition type they require.
+func conditionReferenceAttributes(serviceDef ServiceDefinition) map[string]map[string]string {
+	references := map[string]map[string]string{}
+	for key, s := range blockSchemas(serviceDef) {
+		elem, ok := s.Elem.(*schema.Resource)
+		if !ok {
+			continue
+		}
+		for attr, ty := range conditionReferenceTypes {
+			if _, ok := elem.Schema[attr]; ok {
+				if references[key] == nil {
+					references[key] = map[string]string{}
+				}
+				references[key][attr] = ty
+			}
+		}
+	}
+	return references
+}
+
+// validateServiceConditionReferences checks the condition references of every nested block of serviceDef, and of the
+// waf block, with validateConditionReferences. get returns the value of a service attribute, e.g. ResourceDiff.Get.
+func validateServiceConditionReferences(serviceDef ServiceDefinition, get func(string) interface{}) error {
+	if serviceDef.GetType() != ServiceTypeVCL {
+		return nil
+	}
+	conditions := get("condition").(*schema.Set).List()
+
+	references := conditionReferenceAttributes(serviceDef)
+	var keys []string
+	for key := range references {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		if err := validateConditionReferences(key, get(key).(*schema.Set).List(), conditions, references[key]); err != nil {
+			return err
+		}
+	}
+	if wafs, ok := get("waf").([]interface{}); ok {
+		return validateConditionReferences("waf", wafs, conditions, map[string]string{"prefetch_condition": "PREFETCH"})
+	}
+	return nil
+}
+
+// validateConditionReferences returns an error if an attribute of a block in blocks, e.g. cache_condition, isn't the
+// name of a condition of the type the attribute requires. attributes maps each attribute to that type. The API
+// rejects an unknown condition only once the new service version has been cloned and partly updated, and a condition
+// of another type makes the block never apply.
+func validateConditionReferences(key string, blocks, conditions []interface{}, attributes map[string]string) error {
+	types := map[string]string{}
+	for _, elem := range conditions {
+		condition := elem.(map[string]interface{})
+		name := condition["name"].(string)
+		if name == "" {
+			// The name isn't known until apply.
+			return nil
+		}
+		types[name] = condition["type"].(string)
+	}
+
+	var attrs []string
+	for attr := range attributes {
+		attrs = append(attrs, attr)
+	}
+	sort.Strings(attrs)
+
+	for _, elem := range blocks {
+		block := elem.(map[string]interface{})
+		for _, attr := range attrs {
+			condition, _ := block[attr].(string)
+			if condition == "" {
+				continue
+			}
+			want := attributes[attr]
+			ty, ok := types[condition]
+			if ok && ty == want {
+				continue
+			}
+			if ok {
+				return fmt.Errorf("%s %q references condition %q of type %s in %s, which must be a condition of type %s", key, block["name"], condition, ty, attr, want)
+			}
+
+			var available []string
+			for name, ty := range types {
+				if ty == want {
+					available = append(available, name)
+				}
+			}
+			if len(available) == 0 {
+				return fmt.Errorf("%s %q references condition %q in %s, but no conditions of type %s are defined", key, block["name"], condition, attr, want)
+			}
+			sort.Strings(available)
+			return fmt.Errorf("%s %q references condition %q in %s, which isn't defined. Available %s conditions: %s", key, block["name"], condition, attr, want, strings.Join(available, ", "))
+		}
+	}
+
+	return nil
+}
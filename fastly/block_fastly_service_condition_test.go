@@ -8,6 +8,7 @@ import (
 	gofastly "github.com/fastly/go-fastly/v6/fastly"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
@@ -46,6 +47,92 @@ func TestResourceFastlyFlattenConditions(t *testing.T) {
 
 }
 
+func TestValidateConditionReferences(t *testing.T) {
+	for name, c := range map[string]struct {
+		responseObjects []interface{}
+		conditions      []interface{}
+		expectError     bool
+	}{
+		"no condition referenced": {
+			responseObjects: []interface{}{testResponseObject("maintenance", "", "")},
+		},
+		"defined conditions": {
+			responseObjects: []interface{}{testResponseObject("maintenance", "maintenance", "not-found")},
+			conditions:      []interface{}{testCondition("maintenance", "REQUEST"), testCondition("not-found", "CACHE")},
+		},
+		"undefined condition": {
+			responseObjects: []interface{}{testResponseObject("maintenance", "maintenance-typo", "")},
+			conditions:      []interface{}{testCondition("maintenance", "REQUEST")},
+			expectError:     true,
+		},
+		"no conditions defined": {
+			responseObjects: []interface{}{testResponseObject("maintenance", "", "not-found")},
+			expectError:     true,
+		},
+		"condition of another type": {
+			responseObjects: []interface{}{testResponseObject("maintenance", "", "maintenance")},
+			conditions:      []interface{}{testCondition("maintenance", "REQUEST")},
+			expectError:     true,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			d := testServiceVCLData(t, map[string][]interface{}{"response_object": c.responseObjects, "condition": c.conditions})
+			err := validateConditionReferences("response_object", d.Get("response_object").(*schema.Set).List(), d.Get("condition").(*schema.Set).List(), map[string]string{
+				"request_condition": "REQUEST",
+				"cache_condition":   "CACHE",
+			})
+			testExpectError(t, err, c.expectError)
+		})
+	}
+}
+
+func TestValidateServiceConditionReferences(t *testing.T) {
+	conditions := []interface{}{testCondition("api", "REQUEST"), testCondition("errors", "RESPONSE")}
+	header := func(ty, conditionKey string) map[string]interface{} {
+		h := testHeader("api", "set", "\"1\"")
+		h["type"] = ty
+		h[conditionKey] = "api"
+		return h
+	}
+
+	for name, c := range map[string]struct {
+		blocks      map[string][]interface{}
+		expectError bool
+	}{
+		"valid references": {
+			blocks: map[string][]interface{}{
+				"header":         {header("request", "request_condition")},
+				"logging_syslog": {map[string]interface{}{"name": "errors", "address": "example.com", "response_condition": "errors"}},
+			},
+		},
+		"missing logging response_condition": {
+			blocks: map[string][]interface{}{
+				"logging_syslog": {map[string]interface{}{"name": "errors", "address": "example.com", "response_condition": "errors-typo"}},
+			},
+			expectError: true,
+		},
+		"header condition of another type": {
+			blocks: map[string][]interface{}{
+				"header": {header("response", "response_condition")},
+			},
+			expectError: true,
+		},
+		"missing backend request_condition": {
+			blocks: map[string][]interface{}{
+				"backend": {map[string]interface{}{"name": "origin", "address": "example.com", "request_condition": "admin"}},
+			},
+			expectError: true,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			c.blocks["condition"] = conditions
+			d := testServiceVCLData(t, c.blocks)
+			err := validateServiceConditionReferences(vclService, d.Get)
+			testExpectError(t, err, c.expectError)
+		})
+	}
+}
+
 func TestAccFastlyServiceVCL_conditional_basic(t *testing.T) {
 	var service gofastly.ServiceDetail
 	name := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
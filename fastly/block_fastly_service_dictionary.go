@@ -34,7 +34,7 @@ func (h *DictionaryServiceAttributeHandler) GetSchema() *schema.Schema {
 				"name": {
 					Type:        schema.TypeString,
 					Required:    true,
-					Description: "A unique name to identify this dictionary. It is important to note that changing this attribute will delete and recreate the dictionary, and discard the current items in the dictionary",
+					Description: "A unique name to identify this dictionary. It is important to note that changing this attribute will delete and recreate the dictionary, and discard the current items in the dictionary, unless `renamed_from` is set",
 				},
 				// Optional fields
 				"dictionary_id": {
@@ -54,6 +54,11 @@ func (h *DictionaryServiceAttributeHandler) GetSchema() *schema.Schema {
 					Optional:    true,
 					Description: "Allow the dictionary to be deleted, even if it contains entries. Defaults to false.",
 				},
+				"renamed_from": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "The previous `name` of the dictionary. If the dictionary with that name is removed by the same change, and `write_only` is unchanged, it is renamed in place and keeps its items, rather than being deleted and recreated",
+				},
 			},
 		},
 	}
@@ -92,10 +97,13 @@ func (h *DictionaryServiceAttributeHandler) Read(_ context.Context, d *schema.Re
 	// Match up force_destroy on each ACL from schema.ResourceData to avoid d.Set overwriting it with null
 	stateDicts := d.Get(h.GetKey()).(*schema.Set).List()
 	for _, dictionary := range dictionaries {
+		// Default to false when the dictionary isn't in state yet, e.g. when importing a service.
+		dictionary["force_destroy"] = false
 		for _, sd := range stateDicts {
 			stateDict := sd.(map[string]interface{})
 			if dictionary["name"] == stateDict["name"] {
 				dictionary["force_destroy"] = stateDict["force_destroy"]
+				dictionary["renamed_from"] = stateDict["renamed_from"]
 				break
 			}
 		}
@@ -112,6 +120,32 @@ func (h *DictionaryServiceAttributeHandler) Update(_ context.Context, _ *schema.
 	return nil
 }
 
+// IsRename reports whether a dictionary is renamed in place, which is only
+// done when the new dictionary's renamed_from names the removed one. Changing
+// write_only deletes and recreates the dictionary, so a dictionary replaced by
+// one with a different write_only is not a rename.
+func (h *DictionaryServiceAttributeHandler) IsRename(oldResource, newResource map[string]interface{}) bool {
+	from, _ := newResource["renamed_from"].(string)
+	return from != "" && from == oldResource["name"] && oldResource["write_only"] == newResource["write_only"]
+}
+
+func (h *DictionaryServiceAttributeHandler) Rename(_ context.Context, d *schema.ResourceData, oldResource, newResource map[string]interface {
+}, serviceVersion int, conn *gofastly.Client) error {
+	opts := gofastly.UpdateDictionaryInput{
+		ServiceID:      d.Id(),
+		ServiceVersion: serviceVersion,
+		Name:           oldResource["name"].(string),
+		NewName:        gofastly.String(newResource["name"].(string)),
+	}
+
+	log.Printf("[DEBUG] Fastly Dictionary Rename opts: %#v", opts)
+	_, err := conn.UpdateDictionary(&opts)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
 func (h *DictionaryServiceAttributeHandler) Delete(_ context.Context, d *schema.ResourceData, resource map[string]interface {
 }, serviceVersion int, conn *gofastly.Client) error {
 	if !resource["force_destroy"].(bool) {
@@ -187,3 +221,34 @@ func isDictionaryEmpty(serviceID, dictID string, conn *gofastly.Client) (bool, e
 
 	return len(items) == 0, nil
 }
+
+// validateDictionaryNames returns an error if a name is used by more than one dictionary, or by both a dictionary and
+// an ACL. Both are declared by name in the generated VCL, so the API rejects the collision, but only on apply. acls is
+// nil for services that don't support ACLs.
+func validateDictionaryNames(dictionaries, acls *schema.Set) error {
+	names := map[string]int{}
+	for _, elem := range dictionaries.List() {
+		name := elem.(map[string]interface{})["name"].(string)
+		if name == "" {
+			// The name isn't known until apply.
+			return nil
+		}
+		names[name]++
+	}
+	for name, count := range names {
+		if count > 1 {
+			return fmt.Errorf("dictionary name %q is used by %d dictionary blocks, dictionary names must be unique", name, count)
+		}
+	}
+
+	if acls == nil {
+		return nil
+	}
+	for _, elem := range acls.List() {
+		name := elem.(map[string]interface{})["name"].(string)
+		if _, ok := names[name]; ok {
+			return fmt.Errorf("name %q is used by both a dictionary and an acl block, dictionary and ACL names must not collide", name)
+		}
+	}
+	return nil
+}
@@ -9,6 +9,7 @@ import (
 	gofastly "github.com/fastly/go-fastly/v6/fastly"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
@@ -43,6 +44,133 @@ func TestResourceFastlyFlattenDictionary(t *testing.T) {
 	}
 }
 
+func TestResourceFastlyDictionaryRename(t *testing.T) {
+	h := &DictionaryServiceAttributeHandler{}
+	dictionary := func(name, renamedFrom string, writeOnly bool) map[string]interface{} {
+		return map[string]interface{}{"name": name, "dictionary_id": "", "write_only": writeOnly, "renamed_from": renamedFrom}
+	}
+	for _, c := range []struct {
+		name            string
+		diff            *DiffResult
+		expected        map[string]string
+		expectedDeleted int
+		expectedAdded   int
+	}{
+		{
+			name: "renamed_from set",
+			diff: &DiffResult{
+				Deleted: []interface{}{dictionary("old", "", false)},
+				Added:   []interface{}{dictionary("new", "old", false)},
+			},
+			expected: map[string]string{"old": "new"},
+		},
+		{
+			name: "renamed_from not set",
+			diff: &DiffResult{
+				Deleted: []interface{}{dictionary("old", "", false)},
+				Added:   []interface{}{dictionary("new", "", false)},
+			},
+			expectedDeleted: 1,
+			expectedAdded:   1,
+		},
+		{
+			name: "renamed_from names another dictionary",
+			diff: &DiffResult{
+				Deleted: []interface{}{dictionary("old", "", false)},
+				Added:   []interface{}{dictionary("new", "other", false)},
+			},
+			expectedDeleted: 1,
+			expectedAdded:   1,
+		},
+		{
+			name: "write_only changed",
+			diff: &DiffResult{
+				Deleted: []interface{}{dictionary("old", "", false)},
+				Added:   []interface{}{dictionary("new", "old", true)},
+			},
+			expectedDeleted: 1,
+			expectedAdded:   1,
+		},
+		{
+			name: "several dictionaries renamed",
+			diff: &DiffResult{
+				Deleted: []interface{}{dictionary("a", "", false), dictionary("b", "", false), dictionary("gone", "", false)},
+				Added:   []interface{}{dictionary("d", "b", false), dictionary("c", "a", false), dictionary("fresh", "", false)},
+			},
+			expected:        map[string]string{"a": "c", "b": "d"},
+			expectedDeleted: 1,
+			expectedAdded:   1,
+		},
+	} {
+		t.Run(c.name, func(t *testing.T) {
+			renames := map[string]string{}
+			for _, rename := range findRenames(h, c.diff) {
+				renames[rename[0]["name"].(string)] = rename[1]["name"].(string)
+			}
+			if c.expected == nil {
+				c.expected = map[string]string{}
+			}
+			if !reflect.DeepEqual(renames, c.expected) {
+				t.Fatalf("expected renames %v, got %v", c.expected, renames)
+			}
+			if len(c.diff.Deleted) != c.expectedDeleted || len(c.diff.Added) != c.expectedAdded {
+				t.Fatalf("expected %d deleted and %d added, got %d and %d", c.expectedDeleted, c.expectedAdded, len(c.diff.Deleted), len(c.diff.Added))
+			}
+		})
+	}
+}
+
+func TestResourceFastlyValidateDictionaryNames(t *testing.T) {
+	dictionaryElem := resourceServiceVCL().Schema["dictionary"].Elem.(*schema.Resource)
+	aclElem := resourceServiceVCL().Schema["acl"].Elem.(*schema.Resource)
+
+	for _, c := range []struct {
+		name         string
+		dictionaries []interface{}
+		acls         []interface{}
+		expectError  bool
+	}{
+		{
+			name:         "unique names",
+			dictionaries: []interface{}{map[string]interface{}{"name": "dictionary_a"}, map[string]interface{}{"name": "dictionary_b"}},
+			acls:         []interface{}{map[string]interface{}{"name": "acl_a"}},
+		},
+		{
+			name: "name used by two dictionaries",
+			dictionaries: []interface{}{
+				map[string]interface{}{"name": "dictionary_a", "write_only": false},
+				map[string]interface{}{"name": "dictionary_a", "write_only": true},
+			},
+			expectError: true,
+		},
+		{
+			name:         "name used by a dictionary and an acl",
+			dictionaries: []interface{}{map[string]interface{}{"name": "shared"}},
+			acls:         []interface{}{map[string]interface{}{"name": "shared"}},
+			expectError:  true,
+		},
+	} {
+		t.Run(c.name, func(t *testing.T) {
+			err := validateDictionaryNames(
+				schema.NewSet(schema.HashResource(dictionaryElem), c.dictionaries),
+				schema.NewSet(schema.HashResource(aclElem), c.acls),
+			)
+			if c.expectError && err == nil {
+				t.Fatal("expected an error, got none")
+			}
+			if !c.expectError && err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+		})
+	}
+
+	// Services without ACLs only check the dictionary names
+	dictionaries := schema.NewSet(schema.HashResource(dictionaryElem), []interface{}{map[string]interface{}{"name": "dictionary_a"}})
+	if err := validateDictionaryNames(dictionaries, nil); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+}
+
 func TestAccFastlyServiceVCL_dictionary(t *testing.T) {
 	var service gofastly.ServiceDetail
 	var dictionary gofastly.Dictionary
@@ -52,13 +180,14 @@ func TestAccFastlyServiceVCL_dictionary(t *testing.T) {
 	backendName := fmt.Sprintf("%s.aws.amazon.com", acctest.RandString(3))
 	domainName := fmt.Sprintf("fastly-test.tf-%s.com", acctest.RandString(10))
 
-	// Six part test:
+	// Seven part test:
 	// 1. Create service with dictionary
-	// 2. Rename the dictionary, should succeed because it is empty
-	// 3. Keep dictionary the same and add an item to it
-	// 4. Try to rename it, expect to fail with "dictionary not empty error"
-	// 5. Without renaming, set force_destroy=true to skip the deletion check
-	// 6. Try to rename again, expect to succeed
+	// 2. Import the service, expect the dictionary to match the config
+	// 3. Rename the dictionary, should succeed because it is empty
+	// 4. Keep dictionary the same and add an item to it
+	// 5. Try to rename it, expect to fail with "dictionary not empty error"
+	// 6. Without renaming, set force_destroy=true to skip the deletion check
+	// 7. Try to rename again, expect to succeed
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { testAccPreCheck(t) },
 		ProviderFactories: testAccProviders,
@@ -71,6 +200,13 @@ func TestAccFastlyServiceVCL_dictionary(t *testing.T) {
 					testAccCheckFastlyServiceVCLAttributes_dictionary(&service, &dictionary, name, dictName, false),
 				),
 			},
+			{
+				ResourceName:      "fastly_service_vcl.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// These attributes are not stored on the Fastly API and must be ignored.
+				ImportStateVerifyIgnore: []string{"activate", "force_destroy"},
+			},
 			{
 				Config: testAccServiceVCLConfig_dictionary(name, updatedDictName, backendName, domainName),
 				Check: resource.ComposeTestCheckFunc(
@@ -124,6 +260,59 @@ func TestAccFastlyServiceVCL_dictionary_write_only(t *testing.T) {
 					testAccCheckFastlyServiceVCLAttributes_dictionary(&service, &dictionary, name, dictName, true),
 				),
 			},
+			{
+				ResourceName:      "fastly_service_vcl.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// These attributes are not stored on the Fastly API and must be ignored.
+				ImportStateVerifyIgnore: []string{"activate", "force_destroy"},
+			},
+			// The items of a write-only dictionary can't be read back, so importing them is rejected.
+			{
+				Config:       testAccServiceVCLConfig_dictionary_write_only(name, dictName, backendName, domainName) + testAccServiceVCLConfig_dictionary_write_only_items(dictName),
+				ResourceName: "fastly_service_dictionary_items.items",
+				ImportState:  true,
+				ImportStateIdFunc: func(_ *terraform.State) (string, error) {
+					return fmt.Sprintf("%s/%s", service.ID, dictionary.ID), nil
+				},
+				ExpectError: regexp.MustCompile("is write-only"),
+			},
+		},
+	})
+}
+
+func TestAccFastlyServiceVCL_dictionary_renamedFrom(t *testing.T) {
+	var service gofastly.ServiceDetail
+	var dictionary gofastly.Dictionary
+	name := acctest.RandomWithPrefix(testResourcePrefix)
+	dictName := fmt.Sprintf("dict %s", acctest.RandString(10))
+	updatedDictName := fmt.Sprintf("new dict %s", acctest.RandString(10))
+	backendName := fmt.Sprintf("%s.aws.amazon.com", acctest.RandString(3))
+	domainName := fmt.Sprintf("fastly-test.tf-%s.com", acctest.RandString(10))
+
+	// 1. Create service with dictionary and add an item to it
+	// 2. Rename it with renamed_from, expect the dictionary to be renamed in place and keep its item
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckServiceVCLDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceVCLConfig_dictionary(name, dictName, backendName, domainName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceVCLExists("fastly_service_vcl.foo", &service),
+					testAccCheckFastlyServiceVCLAttributes_dictionary(&service, &dictionary, name, dictName, false),
+					testAccAddDictionaryItems(&dictionary),
+				),
+			},
+			{
+				Config: testAccServiceVCLConfig_dictionaryRenamedFrom(name, updatedDictName, dictName, backendName, domainName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceVCLExists("fastly_service_vcl.foo", &service),
+					testAccCheckFastlyServiceVCLAttributes_dictionary(&service, &dictionary, name, updatedDictName, false),
+					testAccCheckFastlyServiceVCLDictionaryItem(&dictionary),
+				),
+			},
 		},
 	})
 }
@@ -179,6 +368,24 @@ func testAccAddDictionaryItems(dictionary *gofastly.Dictionary) resource.TestChe
 	}
 }
 
+// testAccCheckFastlyServiceVCLDictionaryItem checks that the item added by testAccAddDictionaryItems is still in the
+// dictionary, i.e. that the dictionary wasn't deleted and recreated.
+func testAccCheckFastlyServiceVCLDictionaryItem(dictionary *gofastly.Dictionary) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*FastlyClient).conn
+		_, err := conn.GetDictionaryItem(&gofastly.GetDictionaryItemInput{
+			ServiceID:    dictionary.ServiceID,
+			DictionaryID: dictionary.ID,
+			ItemKey:      "testKey",
+		})
+		if err != nil {
+			return fmt.Errorf("[ERR] Error looking up item in dictionary (%s) on service (%s): %w", dictionary.ID, dictionary.ServiceID, err)
+		}
+
+		return nil
+	}
+}
+
 func testAccServiceVCLConfig_dictionary(name, dictName, backendName, domainName string) string {
 	return fmt.Sprintf(`
 resource "fastly_service_vcl" "foo" {
@@ -226,6 +433,30 @@ resource "fastly_service_vcl" "foo" {
 }`, name, domainName, backendName, dictName)
 }
 
+func testAccServiceVCLConfig_dictionaryRenamedFrom(name, dictName, renamedFrom, backendName, domainName string) string {
+	return fmt.Sprintf(`
+resource "fastly_service_vcl" "foo" {
+  name = "%s"
+
+  domain {
+    name    = "%s"
+    comment = "tf-testing-domain"
+  }
+
+  backend {
+    address = "%s"
+    name    = "tf-test backend"
+  }
+
+  dictionary {
+    name         = "%s"
+    renamed_from = "%s"
+  }
+
+  force_destroy = true
+}`, name, domainName, backendName, dictName, renamedFrom)
+}
+
 func testAccServiceVCLConfig_dictionary_write_only(name, dictName, backendName, domainName string) string {
 	return fmt.Sprintf(`
 resource "fastly_service_vcl" "foo" {
@@ -249,3 +480,14 @@ resource "fastly_service_vcl" "foo" {
   force_destroy = true
 }`, name, domainName, backendName, dictName)
 }
+
+func testAccServiceVCLConfig_dictionary_write_only_items(dictName string) string {
+	return fmt.Sprintf(`
+resource "fastly_service_dictionary_items" "items" {
+  service_id    = fastly_service_vcl.foo.id
+  dictionary_id = {for d in fastly_service_vcl.foo.dictionary : d.name => d.dictionary_id}["%s"]
+  items = {
+    key1 = "value1"
+  }
+}`, dictName)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"sort"
 	"strings"
 
 	gofastly "github.com/fastly/go-fastly/v6/fastly"
@@ -52,7 +53,7 @@ func (h *DirectorServiceAttributeHandler) GetSchema() *schema.Schema {
 					Type:        schema.TypeString,
 					Optional:    true,
 					Default:     "",
-					Description: "Selected POP to serve as a \"shield\" for backends. Valid values for `shield` are included in the [`GET /datacenters`](https://developer.fastly.com/reference/api/utils/datacenter/) API response",
+					Description: "Selected POP to serve as a \"shield\" for backends. Valid values for `shield` are included in the [`GET /datacenters`](https://developer.fastly.com/reference/api/utils/datacenter/) API response. Directors whose backends shield through a different POP are reported as a warning on apply",
 				},
 				"quorum": {
 					Type:             schema.TypeInt,
@@ -74,6 +75,13 @@ func (h *DirectorServiceAttributeHandler) GetSchema() *schema.Schema {
 					Default:     5,
 					Description: "How many backends to search if it fails. Default `5`",
 				},
+				"capacity": {
+					Type:             schema.TypeInt,
+					Optional:         true,
+					Default:          100,
+					Description:      "Load balancing weight for the backends. Default `100`",
+					ValidateDiagFunc: validateDirectorCapacity(),
+				},
 			},
 		},
 	}
@@ -89,6 +97,7 @@ func (h *DirectorServiceAttributeHandler) Create(_ context.Context, d *schema.Re
 		Shield:         resource["shield"].(string),
 		Quorum:         gofastly.Uint(uint(resource["quorum"].(int))),
 		Retries:        gofastly.Uint(uint(resource["retries"].(int))),
+		Capacity:       gofastly.Uint(uint(resource["capacity"].(int))),
 	}
 
 	switch resource["type"].(int) {
@@ -214,6 +223,9 @@ func (h *DirectorServiceAttributeHandler) Update(_ context.Context, d *schema.Re
 	if v, ok := modified["retries"]; ok {
 		opts.Retries = gofastly.Uint(uint(v.(int)))
 	}
+	if v, ok := modified["capacity"]; ok {
+		opts.Capacity = gofastly.Uint(uint(v.(int)))
+	}
 
 	log.Printf("[DEBUG] Update Director Opts: %#v", opts)
 	_, err := conn.UpdateDirector(&opts)
@@ -289,12 +301,13 @@ func flattenDirectors(directorList []*gofastly.Director, directorBackendList []*
 	for _, d := range directorList {
 		// Convert Director to a map for saving to state.
 		nd := map[string]interface{}{
-			"name":    d.Name,
-			"comment": d.Comment,
-			"shield":  d.Shield,
-			"type":    d.Type,
-			"quorum":  int(d.Quorum),
-			"retries": int(d.Retries),
+			"name":     d.Name,
+			"comment":  d.Comment,
+			"shield":   d.Shield,
+			"type":     d.Type,
+			"quorum":   int(d.Quorum),
+			"retries":  int(d.Retries),
+			"capacity": int(d.Capacity),
 		}
 
 		var b []interface{}
@@ -346,3 +359,43 @@ func getDirectorBackendChange(d *schema.ResourceData, resource map[string]interf
 
 	return
 }
+
+// validateDirectorBackends returns an error if a director references a backend that isn't defined in the service. The
+// API rejects such a director backend, but only after the new service version has been cloned and partly updated.
+func validateDirectorBackends(directors, backends *schema.Set) error {
+	names := map[string]bool{}
+	for _, elem := range backends.List() {
+		name := elem.(map[string]interface{})["name"].(string)
+		if name == "" {
+			// The name isn't known until apply.
+			return nil
+		}
+		names[name] = true
+	}
+
+	var available []string
+	for name := range names {
+		available = append(available, name)
+	}
+	sort.Strings(available)
+
+	for _, elem := range directors.List() {
+		director := elem.(map[string]interface{})
+		refs, ok := director["backends"].(*schema.Set)
+		if !ok {
+			continue
+		}
+		for _, ref := range refs.List() {
+			backend := ref.(string)
+			if backend == "" || names[backend] {
+				continue
+			}
+			if len(available) == 0 {
+				return fmt.Errorf("director %q references backend %q, but no backends are defined", director["name"], backend)
+			}
+			return fmt.Errorf("director %q references backend %q, which isn't defined. Available backends: %s", director["name"], backend, strings.Join(available, ", "))
+		}
+	}
+
+	return nil
+}
@@ -44,6 +44,29 @@ func TestResourceFastlyFlattenDirectors(t *testing.T) {
 				},
 			},
 		},
+		{
+			remote_director: []*gofastly.Director{
+				{
+					Name:     "shieldeddirector",
+					Shield:   "amsterdam-nl",
+					Capacity: 50,
+				},
+			},
+			remote_directorbackend: []*gofastly.DirectorBackend{
+				{
+					Director: "shieldeddirector",
+					Backend:  "somebackend",
+				},
+			},
+			local: []map[string]interface{}{
+				{
+					"name":     "shieldeddirector",
+					"shield":   "amsterdam-nl",
+					"capacity": 50,
+					"backends": schema.NewSet(schema.HashString, []interface{}{"somebackend"}),
+				},
+			},
+		},
 		{
 			remote_director: []*gofastly.Director{
 				{
@@ -104,6 +127,12 @@ func TestResourceFastlyFlattenDirectors(t *testing.T) {
 							t.Fatalf("Backends don't match, expected: %#v, got: %#v", lex, oex)
 						}
 					}
+
+					for _, k := range []string{"shield", "capacity"} {
+						if v, ok := l[k]; ok && o[k] != v {
+							t.Fatalf("%s doesn't match, expected: %#v, got: %#v", k, v, o[k])
+						}
+					}
 				}
 			}
 		}
@@ -114,6 +143,37 @@ func TestResourceFastlyFlattenDirectors(t *testing.T) {
 	}
 }
 
+func TestValidateDirectorBackends(t *testing.T) {
+	for name, c := range map[string]struct {
+		backends    []interface{}
+		directors   []interface{}
+		expectError bool
+	}{
+		"defined backends": {
+			backends:  []interface{}{testBackend("origin1", ""), testBackend("origin2", "")},
+			directors: []interface{}{testDirector("", "origin1", "origin2")},
+		},
+		"undefined backend": {
+			backends:    []interface{}{testBackend("origin1", "")},
+			directors:   []interface{}{testDirector("", "origin1", "origin-typo")},
+			expectError: true,
+		},
+		"no backends defined": {
+			directors:   []interface{}{testDirector("", "origin1")},
+			expectError: true,
+		},
+		"no directors": {
+			backends: []interface{}{testBackend("origin1", "")},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			d := testServiceVCLData(t, map[string][]interface{}{"backend": c.backends, "director": c.directors})
+			err := validateDirectorBackends(d.Get("director").(*schema.Set), d.Get("backend").(*schema.Set))
+			testExpectError(t, err, c.expectError)
+		})
+	}
+}
+
 // This test validates that two directors are created successfully,
 // and in the next Terraform run the first director is updated while
 // the second director is unchanged and a third director is added.
@@ -242,6 +302,56 @@ func TestAccFastlyServiceVCL_directors_basic(t *testing.T) {
 	})
 }
 
+// This test validates that a director's shield and capacity are set, and
+// that updating the capacity in the next Terraform run is applied.
+func TestAccFastlyServiceVCL_directors_shieldCapacity(t *testing.T) {
+	var service gofastly.ServiceDetail
+	name := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	domainName1 := fmt.Sprintf("fastly-test.tf-%s.com", acctest.RandString(10))
+
+	directorShielded := gofastly.Director{
+		ServiceVersion: 1,
+		Name:           "director_shielded",
+		Shield:         "amsterdam-nl",
+		Type:           3,
+		Quorum:         75,
+		Capacity:       50,
+		Retries:        5,
+	}
+
+	directorShieldedUpdated := directorShielded
+	directorShieldedUpdated.Capacity = 25
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckServiceVCLDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceVCLDirectorsShieldCapacityConfig(name, domainName1, 50),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceVCLExists("fastly_service_vcl.foo", &service),
+					testAccCheckFastlyServiceVCLDirectorsAttributes(
+						&service,
+						[]*gofastly.Director{&directorShielded}),
+					resource.TestCheckResourceAttr("fastly_service_vcl.foo", "director.#", "1"),
+				),
+			},
+
+			{
+				Config: testAccServiceVCLDirectorsShieldCapacityConfig(name, domainName1, 25),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceVCLExists("fastly_service_vcl.foo", &service),
+					testAccCheckFastlyServiceVCLDirectorsAttributes(
+						&service,
+						[]*gofastly.Director{&directorShieldedUpdated}),
+					resource.TestCheckResourceAttr("fastly_service_vcl.foo", "director.#", "1"),
+				),
+			},
+		},
+	})
+}
+
 // This test validates that two directors are created successfully,
 // and in the next Terraform run the first director is updated while
 // the second director is unchanged and a third director is added.
@@ -609,6 +719,33 @@ resource "fastly_service_vcl" "foo" {
 }`, name, domain)
 }
 
+func testAccServiceVCLDirectorsShieldCapacityConfig(name, domain string, capacity int) string {
+	return fmt.Sprintf(`
+resource "fastly_service_vcl" "foo" {
+  name = "%s"
+
+  domain {
+    name    = "%s"
+    comment = "tf-testing-domain"
+  }
+
+  backend {
+    address = "developer.fastly.com"
+    name    = "developer"
+  }
+
+  director {
+    name = "director_shielded"
+    shield = "amsterdam-nl"
+    type = 3
+    capacity = %d
+    backends = [ "developer" ]
+  }
+
+  force_destroy = true
+}`, name, domain, capacity)
+}
+
 func testAccServiceVCLDirectorsComputeConfig(name, domain string) string {
 	return fmt.Sprintf(`
 resource "fastly_service_compute" "foo" {
@@ -68,6 +68,16 @@ func (h *DynamicSnippetServiceAttributeHandler) Create(_ context.Context, d *sch
 	opts.ServiceID = d.Id()
 	opts.ServiceVersion = serviceVersion
 
+	// When a regular snippet is converted to a dynamic snippet of the same
+	// name, carry its content over. Otherwise the version activated by this
+	// apply would serve an empty snippet until the dynamic snippet content is
+	// set by the fastly_service_dynamic_snippet_content resource.
+	oldSnippets, newSnippets := d.GetChange("snippet")
+	if content, ok := convertedSnippetContent(oldSnippets, newSnippets, opts.Name); ok {
+		log.Printf("[DEBUG] Carrying over content of VCL Snippet (%s) converted to a Dynamic Snippet", opts.Name)
+		opts.Content = content
+	}
+
 	log.Printf("[DEBUG] Fastly VCL Dynamic Snippet Addition opts: %#v", opts)
 	_, err = conn.CreateSnippet(opts)
 	if err != nil {
@@ -159,6 +169,34 @@ func buildDynamicSnippet(dynamicSnippetMap interface{}) (*gofastly.CreateSnippet
 	return &opts, nil
 }
 
+// convertedSnippetContent returns the content of the regular snippet with the given name if it is being removed, i.e.
+// it is in the old set of snippets but not the new one.
+func convertedSnippetContent(oldSnippets, newSnippets interface{}, name string) (string, bool) {
+	find := func(snippets interface{}) map[string]interface{} {
+		s, ok := snippets.(*schema.Set)
+		if !ok {
+			return nil
+		}
+		for _, elem := range s.List() {
+			m := elem.(map[string]interface{})
+			if m["name"] == name {
+				return m
+			}
+		}
+		return nil
+	}
+
+	if find(newSnippets) != nil {
+		return "", false
+	}
+	removed := find(oldSnippets)
+	if removed == nil {
+		return "", false
+	}
+	content, _ := removed["content"].(string)
+	return content, true
+}
+
 func flattenDynamicSnippets(dynamicSnippetList []*gofastly.Snippet) []map[string]interface{} {
 	var sl []map[string]interface{}
 	for _, dynamicSnippet := range dynamicSnippetList {
@@ -8,6 +8,7 @@ import (
 	gofastly "github.com/fastly/go-fastly/v6/fastly"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
@@ -58,6 +59,50 @@ func TestResourceFastlyFlattenDynamicSnippets(t *testing.T) {
 
 }
 
+func TestResourceFastlyConvertedSnippetContent(t *testing.T) {
+	elem := resourceServiceVCL().Schema["snippet"].Elem.(*schema.Resource)
+	snippets := func(names ...string) *schema.Set {
+		var l []interface{}
+		for _, name := range names {
+			l = append(l, map[string]interface{}{"name": name, "type": "recv", "content": "content of " + name, "priority": 100})
+		}
+		return schema.NewSet(schema.HashResource(elem), l)
+	}
+
+	for _, c := range []struct {
+		name            string
+		old             *schema.Set
+		new             *schema.Set
+		expectedContent string
+		expectedOK      bool
+	}{
+		{
+			name:            "snippet converted to a dynamic snippet",
+			old:             snippets("recv_test", "other"),
+			new:             snippets("other"),
+			expectedContent: "content of recv_test",
+			expectedOK:      true,
+		},
+		{
+			name: "snippet kept alongside",
+			old:  snippets("recv_test"),
+			new:  snippets("recv_test"),
+		},
+		{
+			name: "no snippet of the same name",
+			old:  snippets("other"),
+			new:  snippets(),
+		},
+	} {
+		t.Run(c.name, func(t *testing.T) {
+			content, ok := convertedSnippetContent(c.old, c.new, "recv_test")
+			if content != c.expectedContent || ok != c.expectedOK {
+				t.Fatalf("expected (%q, %t), got (%q, %t)", c.expectedContent, c.expectedOK, content, ok)
+			}
+		})
+	}
+}
+
 func TestAccFastlyServiceVCLDynamicSnippet_basic(t *testing.T) {
 	var service gofastly.ServiceDetail
 	name := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
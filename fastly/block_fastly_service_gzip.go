@@ -10,6 +10,32 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
+// gzipDefaultContentTypes and gzipDefaultExtensions mirror the defaults offered by the Fastly UI when a new gzip
+// configuration is created.
+var (
+	gzipDefaultContentTypes = []string{
+		"text/html",
+		"application/x-javascript",
+		"text/css",
+		"application/javascript",
+		"text/javascript",
+		"application/json",
+		"application/vnd.ms-fontobject",
+		"application/x-font-opentype",
+		"application/x-font-truetype",
+		"application/x-font-ttf",
+		"application/xml",
+		"font/eot",
+		"font/opentype",
+		"font/otf",
+		"image/svg+xml",
+		"image/vnd.microsoft.icon",
+		"text/plain",
+		"text/xml",
+	}
+	gzipDefaultExtensions = []string{"css", "js", "html", "eot", "ico", "otf", "ttf", "json", "svg"}
+)
+
 type GzipServiceAttributeHandler struct {
 	*DefaultServiceAttributeHandler
 }
@@ -56,6 +82,12 @@ func (h *GzipServiceAttributeHandler) GetSchema() *schema.Schema {
 					Default:     "",
 					Description: "Name of already defined `condition` controlling when this gzip configuration applies. This `condition` must be of type `CACHE`. For detailed information about Conditionals, see [Fastly's Documentation on Conditionals](https://docs.fastly.com/en/guides/using-conditions)",
 				},
+				"use_default_content_types": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     false,
+					Description: "Populate `content_types` and `extensions` with the Fastly default list when they are not set. Explicitly set values are left untouched. Default `false`",
+				},
 			},
 		},
 	}
@@ -78,6 +110,15 @@ func (h *GzipServiceAttributeHandler) Create(_ context.Context, d *schema.Resour
 		opts.Extensions = sliceToString(v.([]interface{}))
 	}
 
+	if resource["use_default_content_types"].(bool) {
+		if opts.ContentTypes == "" {
+			opts.ContentTypes = strings.Join(gzipDefaultContentTypes, " ")
+		}
+		if opts.Extensions == "" {
+			opts.Extensions = strings.Join(gzipDefaultExtensions, " ")
+		}
+	}
+
 	log.Printf("[DEBUG] Fastly Gzip Addition opts: %#v", opts)
 	_, err := conn.CreateGzip(&opts)
 	if err != nil {
@@ -103,15 +144,18 @@ func (h *GzipServiceAttributeHandler) Read(_ context.Context, d *schema.Resource
 	// Fastly API will actually set the default value silently when these fields are not sent
 	// or an empty field value is sent. This will cause unexpected diff.
 	// We need to ignore these fields in the API response unless field values are explicitly set.
+	// The same applies when "use_default_content_types" populated them with the default list.
 	{
 		type IgnoreFields struct {
 			Name string
 		}
 		ignoreList := map[string][]IgnoreFields{}
+		useDefaults := map[string]interface{}{}
 
 		for _, elem := range d.Get("gzip").(*schema.Set).List() {
 			m := elem.(map[string]interface{})
 			name := m["name"].(string)
+			useDefaults[name] = m["use_default_content_types"]
 			if len(m["content_types"].([]interface{})) == 0 {
 				ignoreList[name] = append(ignoreList[name], IgnoreFields{Name: "content_types"})
 			}
@@ -126,6 +170,10 @@ func (h *GzipServiceAttributeHandler) Read(_ context.Context, d *schema.Resource
 					gl[i][sl.Name] = nil
 				}
 			}
+			// Match up use_default_content_types from schema.ResourceData to avoid d.Set overwriting it with null
+			if v, ok := useDefaults[g["name"].(string)]; ok {
+				gl[i]["use_default_content_types"] = v
+			}
 		}
 	}
 
@@ -144,6 +192,17 @@ func (h *GzipServiceAttributeHandler) Update(_ context.Context, d *schema.Resour
 		Name:           resource["name"].(string),
 	}
 
+	useDefaults := resource["use_default_content_types"].(bool)
+	if _, ok := modified["use_default_content_types"]; ok {
+		// Toggling the flag affects whichever of the lists are unset, so treat both as modified.
+		if _, ok := modified["content_types"]; !ok {
+			modified["content_types"] = resource["content_types"]
+		}
+		if _, ok := modified["extensions"]; !ok {
+			modified["extensions"] = resource["extensions"]
+		}
+	}
+
 	// NOTE: where we transition between interface{} we lose the ability to
 	// infer the underlying type being either a uint vs an int. This
 	// materializes as a panic (yay) and so it's only at runtime we discover
@@ -159,6 +218,8 @@ func (h *GzipServiceAttributeHandler) Update(_ context.Context, d *schema.Resour
 		list := v.([]interface{})
 		if len(list) > 0 {
 			opts.ContentTypes = gofastly.String(sliceToString(list))
+		} else if useDefaults {
+			opts.ContentTypes = gofastly.String(strings.Join(gzipDefaultContentTypes, " "))
 		}
 	}
 	if v, ok := modified["extensions"]; ok {
@@ -166,6 +227,8 @@ func (h *GzipServiceAttributeHandler) Update(_ context.Context, d *schema.Resour
 		list := v.([]interface{})
 		if len(list) > 0 {
 			opts.Extensions = gofastly.String(sliceToString(list))
+		} else if useDefaults {
+			opts.Extensions = gofastly.String(strings.Join(gzipDefaultExtensions, " "))
 		}
 	}
 	if v, ok := modified["cache_condition"]; ok {
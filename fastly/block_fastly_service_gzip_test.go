@@ -3,6 +3,7 @@ package fastly
 import (
 	"fmt"
 	"reflect"
+	"strings"
 	"testing"
 
 	gofastly "github.com/fastly/go-fastly/v6/fastly"
@@ -142,6 +143,57 @@ func TestAccFastlyServiceVCL_gzips_basic(t *testing.T) {
 	})
 }
 
+func TestAccFastlyServiceVCL_gzips_useDefaultContentTypes(t *testing.T) {
+	var service gofastly.ServiceDetail
+	name := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	domainName1 := fmt.Sprintf("fastly-test.tf-%s.com", acctest.RandString(10))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckServiceVCLDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceVCLGzipsConfig_useDefaultContentTypes(name, domainName1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceVCLExists("fastly_service_vcl.foo", &service),
+					testAccCheckFastlyServiceVCLGzipsDefaults(&service, "defaults"),
+					resource.TestCheckResourceAttr(
+						"fastly_service_vcl.foo", "gzip.#", "1"),
+				),
+			},
+			{
+				// Re-applying the same configuration must not produce a diff.
+				Config:   testAccServiceVCLGzipsConfig_useDefaultContentTypes(name, domainName1),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func testAccCheckFastlyServiceVCLGzipsDefaults(service *gofastly.ServiceDetail, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*FastlyClient).conn
+		g, err := conn.GetGzip(&gofastly.GetGzipInput{
+			ServiceID:      service.ID,
+			ServiceVersion: service.ActiveVersion.Number,
+			Name:           name,
+		})
+		if err != nil {
+			return fmt.Errorf("[ERR] Error looking up Gzip (%s) for (%s), version (%v): %s", name, service.Name, service.ActiveVersion.Number, err)
+		}
+
+		if g.ContentTypes != strings.Join(gzipDefaultContentTypes, " ") {
+			return fmt.Errorf("Bad content types, expected defaults, got (%s)", g.ContentTypes)
+		}
+		if g.Extensions != strings.Join(gzipDefaultExtensions, " ") {
+			return fmt.Errorf("Bad extensions, expected defaults, got (%s)", g.Extensions)
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckFastlyServiceVCLGzipsAttributes(service *gofastly.ServiceDetail, gzips []*gofastly.Gzip) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 
@@ -291,3 +343,27 @@ resource "fastly_service_vcl" "foo" {
   force_destroy = true
 }`, name, domain)
 }
+
+func testAccServiceVCLGzipsConfig_useDefaultContentTypes(name, domain string) string {
+	return fmt.Sprintf(`
+resource "fastly_service_vcl" "foo" {
+  name = "%s"
+
+  domain {
+    name    = "%s"
+    comment = "tf-testing-domain"
+  }
+
+  backend {
+    address = "aws.amazon.com"
+    name    = "amazon docs"
+  }
+
+  gzip {
+    name                      = "defaults"
+    use_default_content_types = true
+  }
+
+  force_destroy = true
+}`, name, domain)
+}
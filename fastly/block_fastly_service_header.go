@@ -4,9 +4,11 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"sort"
 	"strings"
 
 	gofastly "github.com/fastly/go-fastly/v6/fastly"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -50,9 +52,10 @@ func (h *HeaderServiceAttributeHandler) GetSchema() *schema.Schema {
 					ValidateDiagFunc: validateHeaderType(),
 				},
 				"destination": {
-					Type:        schema.TypeString,
-					Required:    true,
-					Description: "The name of the header that is going to be affected by the Action",
+					Type:             schema.TypeString,
+					Required:         true,
+					Description:      "The name of the header that is going to be affected by the Action, written as `http.Header-Name`, or the VCL variable to write to, e.g. `url`",
+					ValidateDiagFunc: validateHeaderDestination(),
 				},
 				// Optional fields, defaults where they exist
 				"ignore_if_set": {
@@ -65,7 +68,7 @@ func (h *HeaderServiceAttributeHandler) GetSchema() *schema.Schema {
 					Type:        schema.TypeString,
 					Optional:    true,
 					Computed:    true,
-					Description: "Variable to be used as a source for the header content (Does not apply to `delete` action.)",
+					Description: "Variable to be used as a source for the header content (Does not apply to `delete` action.). Required for the `set` and `append` actions",
 				},
 				"regex": {
 					Type:        schema.TypeString,
@@ -83,7 +86,7 @@ func (h *HeaderServiceAttributeHandler) GetSchema() *schema.Schema {
 					Type:        schema.TypeInt,
 					Optional:    true,
 					Default:     100,
-					Description: "Lower priorities execute first. Default: `100`",
+					Description: "Lower priorities execute first. Headers of the same `type` sharing a priority have no guaranteed execution order, so set distinct priorities where order matters. Headers sharing a priority are reported as a warning on apply. Default: `100`",
 				},
 				"request_condition": {
 					Type:        schema.TypeString,
@@ -294,3 +297,63 @@ func buildHeader(headerMap interface{}) (*gofastly.CreateHeaderInput, error) {
 
 	return &opts, nil
 }
+
+// headerPriorityWarnings returns a warning for every group of headers which share both a type and a priority. The
+// execution order within such a group is not guaranteed, which is easy to miss as every header defaults to priority 100.
+func headerPriorityWarnings(d *schema.ResourceData) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	headers, ok := d.Get("header").(*schema.Set)
+	if !ok {
+		return diags
+	}
+
+	type group struct {
+		ty       string
+		priority int
+	}
+	groups := make(map[group][]string)
+	for _, elem := range headers.List() {
+		m := elem.(map[string]interface{})
+		g := group{strings.ToLower(m["type"].(string)), m["priority"].(int)}
+		groups[g] = append(groups[g], m["name"].(string))
+	}
+
+	var keys []group
+	for g, names := range groups {
+		if len(names) > 1 {
+			keys = append(keys, g)
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].ty != keys[j].ty {
+			return keys[i].ty < keys[j].ty
+		}
+		return keys[i].priority < keys[j].priority
+	})
+
+	for _, g := range keys {
+		names := groups[g]
+		sort.Strings(names)
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "Headers share the same priority",
+			Detail:   fmt.Sprintf("Headers %s of type '%s' all have priority %d, so the order in which they execute is not guaranteed. Set distinct priorities if their order matters", strings.Join(names, ", "), g.ty, g.priority),
+		})
+	}
+
+	return diags
+}
+
+// validateHeaderSources returns an error for a `set` or `append` header without a source. Fastly accepts such a
+// header, but it has nothing to write to its destination, so the rule silently never has any effect.
+func validateHeaderSources(headers *schema.Set) error {
+	for _, elem := range headers.List() {
+		m := elem.(map[string]interface{})
+		action := strings.ToLower(m["action"].(string))
+		if (action == "set" || action == "append") && strings.TrimSpace(m["source"].(string)) == "" {
+			return fmt.Errorf("header %q uses the %s action but has no source, set source to the VCL variable or string to write to its destination", m["name"], action)
+		}
+	}
+	return nil
+}
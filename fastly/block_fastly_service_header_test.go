@@ -8,6 +8,7 @@ import (
 	gofastly "github.com/fastly/go-fastly/v6/fastly"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
@@ -120,6 +121,73 @@ func TestFastlyServiceVCL_BuildHeaders(t *testing.T) {
 	}
 }
 
+func TestResourceFastlyHeaderPriorityWarnings(t *testing.T) {
+	header := func(name, ty string, priority int) map[string]interface{} {
+		h := testHeader(name, "set", "")
+		h["type"] = ty
+		h["priority"] = priority
+		return h
+	}
+
+	for _, c := range []struct {
+		name     string
+		headers  []interface{}
+		expected int
+	}{
+		{
+			name:     "same type and priority",
+			headers:  []interface{}{header("a", "request", 100), header("b", "request", 100), header("c", "response", 100)},
+			expected: 1,
+		},
+		{
+			name:     "distinct priorities",
+			headers:  []interface{}{header("a", "request", 10), header("b", "request", 20)},
+			expected: 0,
+		},
+		{
+			name:     "same priority different types",
+			headers:  []interface{}{header("a", "request", 100), header("b", "cache", 100)},
+			expected: 0,
+		},
+	} {
+		t.Run(c.name, func(t *testing.T) {
+			d := testServiceVCLData(t, map[string][]interface{}{"header": c.headers})
+			diags := headerPriorityWarnings(d)
+			if len(diags) != c.expected {
+				t.Fatalf("expected %d warnings, got %d: %#v", c.expected, len(diags), diags)
+			}
+		})
+	}
+}
+
+func TestValidateHeaderSources(t *testing.T) {
+	for name, c := range map[string]struct {
+		headers     []interface{}
+		expectError bool
+	}{
+		"set without source": {
+			headers:     []interface{}{testHeader("a", "set", "")},
+			expectError: true,
+		},
+		"append without source": {
+			headers:     []interface{}{testHeader("a", "append", " ")},
+			expectError: true,
+		},
+		"set with source": {
+			headers: []interface{}{testHeader("a", "set", "req.http.host")},
+		},
+		"delete without source": {
+			headers: []interface{}{testHeader("a", "delete", "")},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			d := testServiceVCLData(t, map[string][]interface{}{"header": c.headers})
+			err := validateHeaderSources(d.Get("header").(*schema.Set))
+			testExpectError(t, err, c.expectError)
+		})
+	}
+}
+
 func TestAccFastlyServiceVCL_headers_basic(t *testing.T) {
 	var service gofastly.ServiceDetail
 	name := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
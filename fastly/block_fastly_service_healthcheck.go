@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"sort"
+	"strings"
 
 	gofastly "github.com/fastly/go-fastly/v6/fastly"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -251,3 +253,41 @@ func flattenHealthchecks(healthcheckList []*gofastly.HealthCheck) []map[string]i
 
 	return hl
 }
+
+// validateBackendHealthchecks returns an error if a healthcheck name is defined more than once, or if a backend
+// references a healthcheck that isn't defined. The API accepts unknown healthcheck names, which leaves the backend
+// without a healthcheck.
+func validateBackendHealthchecks(backends, healthchecks *schema.Set) error {
+	names := map[string]int{}
+	for _, elem := range healthchecks.List() {
+		name := elem.(map[string]interface{})["name"].(string)
+		if name == "" {
+			// The name isn't known until apply.
+			return nil
+		}
+		names[name]++
+	}
+
+	var available []string
+	for name, count := range names {
+		if count > 1 {
+			return fmt.Errorf("healthcheck name %q is used by %d healthchecks, healthcheck names must be unique", name, count)
+		}
+		available = append(available, name)
+	}
+	sort.Strings(available)
+
+	for _, elem := range backends.List() {
+		backend := elem.(map[string]interface{})
+		healthcheck, _ := backend["healthcheck"].(string)
+		if healthcheck == "" || names[healthcheck] > 0 {
+			continue
+		}
+		if len(available) == 0 {
+			return fmt.Errorf("backend %q references healthcheck %q, but no healthchecks are defined", backend["name"], healthcheck)
+		}
+		return fmt.Errorf("backend %q references healthcheck %q, which isn't defined. Available healthchecks: %s", backend["name"], healthcheck, strings.Join(available, ", "))
+	}
+
+	return nil
+}
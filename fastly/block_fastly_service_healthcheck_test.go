@@ -8,6 +8,7 @@ import (
 	gofastly "github.com/fastly/go-fastly/v6/fastly"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
@@ -61,6 +62,52 @@ func TestResourceFastlyFlattenHealthChecks(t *testing.T) {
 
 }
 
+func TestValidateBackendHealthchecks(t *testing.T) {
+	backend := func(healthcheck string) map[string]interface{} {
+		b := testBackend("backend", "")
+		b["healthcheck"] = healthcheck
+		return b
+	}
+
+	for name, c := range map[string]struct {
+		backends     []interface{}
+		healthchecks []interface{}
+		expectError  bool
+	}{
+		"no healthcheck referenced": {
+			backends:     []interface{}{backend("")},
+			healthchecks: []interface{}{testHealthcheck("hc-one")},
+		},
+		"defined healthcheck": {
+			backends:     []interface{}{backend("hc-one")},
+			healthchecks: []interface{}{testHealthcheck("hc-one"), testHealthcheck("hc-two")},
+		},
+		"undefined healthcheck": {
+			backends:     []interface{}{backend("hc-typo")},
+			healthchecks: []interface{}{testHealthcheck("hc-one")},
+			expectError:  true,
+		},
+		"no healthchecks defined": {
+			backends:    []interface{}{backend("hc-one")},
+			expectError: true,
+		},
+		"duplicate healthcheck names": {
+			backends: []interface{}{backend("hc-one")},
+			healthchecks: []interface{}{
+				testHealthcheck("hc-one"),
+				map[string]interface{}{"name": "hc-one", "host": "example.net", "path": "/status"},
+			},
+			expectError: true,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			d := testServiceVCLData(t, map[string][]interface{}{"backend": c.backends, "healthcheck": c.healthchecks})
+			err := validateBackendHealthchecks(d.Get("backend").(*schema.Set), d.Get("healthcheck").(*schema.Set))
+			testExpectError(t, err, c.expectError)
+		})
+	}
+}
+
 func TestAccFastlyServiceVCL_healthcheck_basic(t *testing.T) {
 	var service gofastly.ServiceDetail
 	name := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
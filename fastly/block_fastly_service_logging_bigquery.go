@@ -60,25 +60,32 @@ func (h *BigQueryLoggingServiceAttributeHandler) GetSchema() *schema.Schema {
 			Type:             schema.TypeString,
 			Required:         true,
 			DefaultFunc:      schema.EnvDefaultFunc("FASTLY_BQ_SECRET_KEY", ""),
-			Description:      "The secret key associated with the service account that has write access to your BigQuery table. If not provided, this will be pulled from the `FASTLY_BQ_SECRET_KEY` environment variable. Typical format for this is a private key in a string with newlines",
+			Description:      "The secret key associated with the service account that has write access to your BigQuery table. If not provided, this will be pulled from the `FASTLY_BQ_SECRET_KEY` environment variable. Typical format for this is a private key in a string with newlines. If the service account JSON is given instead, it must contain the `client_email` and `private_key` fields",
 			Sensitive:        true,
-			ValidateDiagFunc: validateStringTrimmed,
+			ValidateDiagFunc: validateAll(validateStringTrimmed, validateGCPSecretKey()),
 		},
 		// Optional fields
 		"template": {
 			Type:        schema.TypeString,
 			Optional:    true,
 			Default:     "",
-			Description: "BigQuery table name suffix template",
+			Description: "BigQuery table name suffix template. strftime tokens, e.g. `%Y%m%d` for a table per day, are expanded in UTC; the Fastly API has no timezone setting for the template",
 		},
 	}
 
 	if h.GetServiceMetadata().serviceType == ServiceTypeVCL {
 		blockAttributes["format"] = &schema.Schema{
-			Type:        schema.TypeString,
+			Type:             schema.TypeString,
+			Optional:         true,
+			Description:      "The logging format desired.",
+			Default:          "%h %l %u %t \"%r\" %>s %b",
+			ValidateDiagFunc: validateLoggingFormat(),
+		}
+		blockAttributes["append_newline"] = &schema.Schema{
+			Type:        schema.TypeBool,
 			Optional:    true,
-			Description: "The logging format desired.",
-			Default:     "%h %l %u %t \"%r\" %>s %b",
+			Default:     true,
+			Description: loggingAppendNewlineDescription,
 		}
 		blockAttributes["response_condition"] = &schema.Schema{
 			Type:        schema.TypeString,
@@ -123,7 +130,9 @@ func (h *BigQueryLoggingServiceAttributeHandler) Create(_ context.Context, d *sc
 		opts.Format = vla.format
 	}
 
-	log.Printf("[DEBUG] Create BigQuery opts: %#v", opts)
+	logOpts := opts
+	logOpts.SecretKey = "<sensitive>"
+	log.Printf("[DEBUG] Create BigQuery opts: %#v", logOpts)
 	_, err := conn.CreateBigQuery(&opts)
 	if err != nil {
 		return err
@@ -147,6 +156,8 @@ func (h *BigQueryLoggingServiceAttributeHandler) Read(_ context.Context, d *sche
 
 	for _, element := range bql {
 		element = h.pruneVCLLoggingAttributes(element)
+		h.restoreLoggingFormat(d, element)
+		h.restoreLoggingPlacement(d, element)
 	}
 
 	if err := d.Set(h.GetKey(), bql); err != nil {
@@ -157,8 +168,21 @@ func (h *BigQueryLoggingServiceAttributeHandler) Read(_ context.Context, d *sche
 }
 
 func (h *BigQueryLoggingServiceAttributeHandler) Update(_ context.Context, d *schema.ResourceData, resource, modified map[string]interface{}, serviceVersion int, conn *gofastly.Client) error {
+	opts := h.buildUpdate(resource, modified, d.Id(), serviceVersion)
+
+	log.Printf("[DEBUG] Update BigQuery Opts: %#v", opts)
+	_, err := conn.UpdateBigQuery(&opts)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// buildUpdate returns the update input for a BigQuery logging endpoint, setting only the modified attributes.
+func (h *BigQueryLoggingServiceAttributeHandler) buildUpdate(resource, modified map[string]interface{}, serviceID string, serviceVersion int) gofastly.UpdateBigQueryInput {
 	opts := gofastly.UpdateBigQueryInput{
-		ServiceID:      d.Id(),
+		ServiceID:      serviceID,
 		ServiceVersion: serviceVersion,
 		Name:           resource["name"].(string),
 	}
@@ -172,17 +196,17 @@ func (h *BigQueryLoggingServiceAttributeHandler) Update(_ context.Context, d *sc
 	if v, ok := modified["table"]; ok {
 		opts.Table = gofastly.String(v.(string))
 	}
-	if v, ok := modified["template_suffix"]; ok {
+	if v, ok := modified["template"]; ok {
 		opts.Template = gofastly.String(v.(string))
 	}
-	if v, ok := modified["user"]; ok {
+	if v, ok := modified["email"]; ok {
 		opts.User = gofastly.String(v.(string))
 	}
 	if v, ok := modified["secret_key"]; ok {
 		opts.SecretKey = gofastly.String(v.(string))
 	}
-	if v, ok := modified["format"]; ok {
-		opts.Format = gofastly.String(v.(string))
+	if v := h.modifiedLoggingFormat(resource, modified); v != nil {
+		opts.Format = v
 	}
 	if v, ok := modified["response_condition"]; ok {
 		opts.ResponseCondition = gofastly.String(v.(string))
@@ -199,13 +223,7 @@ func (h *BigQueryLoggingServiceAttributeHandler) Update(_ context.Context, d *sc
 		opts.FormatVersion = gofastly.Uint(uint(v.(int)))
 	}
 
-	log.Printf("[DEBUG] Update BigQuery Opts: %#v", opts)
-	_, err := conn.UpdateBigQuery(&opts)
-	if err != nil {
-		return err
-	}
-
-	return nil
+	return opts
 }
 
 func (h *BigQueryLoggingServiceAttributeHandler) Delete(_ context.Context, d *schema.ResourceData, resource map[string]interface{}, serviceVersion int, conn *gofastly.Client) error {
@@ -339,3 +339,33 @@ func TestResourceFastlyFlattenBigQuery(t *testing.T) {
 		}
 	}
 }
+
+func TestResourceFastlyBigQueryBuildUpdate(t *testing.T) {
+	h := &BigQueryLoggingServiceAttributeHandler{
+		&DefaultServiceAttributeHandler{
+			key:             "logging_bigquery",
+			serviceMetadata: ServiceMetadata{serviceType: ServiceTypeVCL},
+		},
+	}
+	resource := map[string]interface{}{
+		"name":     "bigquery-example",
+		"email":    "new@example.com",
+		"template": "%Y%m%d",
+	}
+	modified := map[string]interface{}{
+		"email":    "new@example.com",
+		"template": "%Y%m%d",
+	}
+
+	opts := h.buildUpdate(resource, modified, "service", 2)
+
+	if opts.Template == nil || *opts.Template != "%Y%m%d" {
+		t.Errorf("Error matching template:\nexpected: %#v\n     got: %#v", gofastly.String("%Y%m%d"), opts.Template)
+	}
+	if opts.User == nil || *opts.User != "new@example.com" {
+		t.Errorf("Error matching email:\nexpected: %#v\n     got: %#v", gofastly.String("new@example.com"), opts.User)
+	}
+	if opts.Table != nil {
+		t.Errorf("Expected unchanged table not to be sent, got: %#v", *opts.Table)
+	}
+}
@@ -56,10 +56,11 @@ func (h *BlobStorageLoggingServiceAttributeHandler) GetSchema() *schema.Schema {
 			Description: "The path to upload logs to. Must end with a trailing slash. If this field is left empty, the files will be saved in the container's root path",
 		},
 		"period": {
-			Type:        schema.TypeInt,
-			Optional:    true,
-			Default:     3600,
-			Description: "How frequently the logs should be transferred in seconds. Default `3600`",
+			Type:             schema.TypeInt,
+			Optional:         true,
+			Default:          3600,
+			Description:      "How frequently the logs should be transferred in seconds. Default `3600`",
+			ValidateDiagFunc: validateLoggingPeriod(),
 		},
 		"timestamp_format": {
 			Type:        schema.TypeString,
@@ -68,10 +69,11 @@ func (h *BlobStorageLoggingServiceAttributeHandler) GetSchema() *schema.Schema {
 			Description: TimestampFormatDescription,
 		},
 		"gzip_level": {
-			Type:        schema.TypeInt,
-			Optional:    true,
-			Default:     0,
-			Description: GzipLevelDescription,
+			Type:             schema.TypeInt,
+			Optional:         true,
+			Default:          0,
+			Description:      GzipLevelDescription,
+			ValidateDiagFunc: validateLoggingGzipLevel(),
 		},
 		"public_key": {
 			Type:             schema.TypeString,
@@ -101,10 +103,17 @@ func (h *BlobStorageLoggingServiceAttributeHandler) GetSchema() *schema.Schema {
 
 	if h.GetServiceMetadata().serviceType == ServiceTypeVCL {
 		blockAttributes["format"] = &schema.Schema{
-			Type:        schema.TypeString,
+			Type:             schema.TypeString,
+			Optional:         true,
+			Default:          "%h %l %u %t \"%r\" %>s %b",
+			Description:      "Apache-style string or VCL variables to use for log formatting (default: `%h %l %u %t \"%r\" %>s %b`)",
+			ValidateDiagFunc: validateLoggingFormat(),
+		}
+		blockAttributes["append_newline"] = &schema.Schema{
+			Type:        schema.TypeBool,
 			Optional:    true,
-			Default:     "%h %l %u %t \"%r\" %>s %b",
-			Description: "Apache-style string or VCL variables to use for log formatting (default: `%h %l %u %t \"%r\" %>s %b`)",
+			Default:     true,
+			Description: loggingAppendNewlineDescription,
 		}
 		blockAttributes["format_version"] = &schema.Schema{
 			Type:             schema.TypeInt,
@@ -137,6 +146,10 @@ func (h *BlobStorageLoggingServiceAttributeHandler) GetSchema() *schema.Schema {
 
 func (h *BlobStorageLoggingServiceAttributeHandler) Create(_ context.Context, d *schema.ResourceData, resource map[string]interface {
 }, serviceVersion int, conn *gofastly.Client) error {
+	if err := checkLoggingCompression(resource); err != nil {
+		return err
+	}
+
 	var vla = h.getVCLLoggingAttributes(resource)
 	opts := gofastly.CreateBlobStorageInput{
 		ServiceID:         d.Id(),
@@ -182,6 +195,8 @@ func (h *BlobStorageLoggingServiceAttributeHandler) Read(_ context.Context, d *s
 
 	for _, element := range bsl {
 		element = h.pruneVCLLoggingAttributes(element)
+		h.restoreLoggingFormat(d, element)
+		h.restoreLoggingPlacement(d, element)
 	}
 
 	if err := d.Set(h.GetKey(), bsl); err != nil {
@@ -192,6 +207,10 @@ func (h *BlobStorageLoggingServiceAttributeHandler) Read(_ context.Context, d *s
 
 func (h *BlobStorageLoggingServiceAttributeHandler) Update(_ context.Context, d *schema.ResourceData, resource, modified map[string]interface {
 }, serviceVersion int, conn *gofastly.Client) error {
+	if err := checkLoggingCompression(resource); err != nil {
+		return err
+	}
+
 	opts := gofastly.UpdateBlobStorageInput{
 		ServiceID:      d.Id(),
 		ServiceVersion: serviceVersion,
@@ -230,8 +249,8 @@ func (h *BlobStorageLoggingServiceAttributeHandler) Update(_ context.Context, d
 	if v, ok := modified["public_key"]; ok {
 		opts.PublicKey = gofastly.String(v.(string))
 	}
-	if v, ok := modified["format"]; ok {
-		opts.Format = gofastly.String(v.(string))
+	if v := h.modifiedLoggingFormat(resource, modified); v != nil {
+		opts.Format = v
 	}
 	if v, ok := modified["format_version"]; ok {
 		opts.FormatVersion = gofastly.Uint(uint(v.(int)))
@@ -306,6 +325,7 @@ func flattenBlobStorages(blobStorageList []*gofastly.BlobStorage) []map[string]i
 				delete(nbs, k)
 			}
 		}
+		pruneLoggingCompression(nbs)
 
 		bsl = append(bsl, nbs)
 	}
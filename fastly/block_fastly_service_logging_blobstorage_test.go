@@ -51,7 +51,6 @@ func TestResourceFastlyFlattenBlobStorage(t *testing.T) {
 					"public_key":         "test-public-key",
 					"format":             "%h %l %u %t \"%r\" %>s %b",
 					"format_version":     uint(2),
-					"gzip_level":         uint(0),
 					"message_type":       "classic",
 					"placement":          "waf_debug",
 					"response_condition": "error_response",
@@ -83,7 +82,7 @@ func TestAccFastlyServiceVCL_blobstoragelogging_basic(t *testing.T) {
 		Period:            12,
 		TimestampFormat:   "%Y-%m-%dT%H:%M:%S.000",
 		PublicKey:         pgpPublicKey(t),
-		Format:            "%h %l %u %t \"%r\" %>s %b",
+		Format:            appendNewLine("%h %l %u %t \"%r\" %>s %b"),
 		FormatVersion:     1,
 		MessageType:       "blank",
 		Placement:         "waf_debug",
@@ -101,7 +100,7 @@ func TestAccFastlyServiceVCL_blobstoragelogging_basic(t *testing.T) {
 		Period:            12,
 		TimestampFormat:   "%Y-%m-%dT%H:%M:%S.000",
 		PublicKey:         pgpPublicKey(t),
-		Format:            "%h %l %u %{now}V %{req.method}V %{req.url}V %>s %{resp.http.Content-Length}V",
+		Format:            appendNewLine("%h %l %u %{now}V %{req.method}V %{req.url}V %>s %{resp.http.Content-Length}V"),
 		FormatVersion:     2,
 		GzipLevel:         1,
 		MessageType:       "blank",
@@ -119,7 +118,7 @@ func TestAccFastlyServiceVCL_blobstoragelogging_basic(t *testing.T) {
 		Period:            12,
 		TimestampFormat:   "%Y-%m-%dT%H:%M:%S.000",
 		PublicKey:         pgpPublicKey(t),
-		Format:            "%h %l %u %{now}V %{req.method}V %{req.url}V %>s %{resp.http.Content-Length}V",
+		Format:            appendNewLine("%h %l %u %{now}V %{req.method}V %{req.url}V %>s %{resp.http.Content-Length}V"),
 		FormatVersion:     2,
 		MessageType:       "blank",
 		Placement:         "waf_debug",
@@ -209,7 +208,7 @@ func TestAccFastlyServiceVCL_blobstoragelogging_default(t *testing.T) {
 		SASToken:        "sv=2018-04-05&ss=b&srt=sco&sp=rw&se=2050-07-21T18%3A00%3A00Z&sig=3ABdLOJZosCp0o491T%2BqZGKIhafF1nlM3MzESDDD3Gg%3D",
 		Period:          3600,
 		TimestampFormat: "%Y-%m-%dT%H:%M:%S.000",
-		Format:          "%h %l %u %t \"%r\" %>s %b",
+		Format:          appendNewLine("%h %l %u %t \"%r\" %>s %b"),
 		FormatVersion:   2,
 		MessageType:     "classic",
 	}
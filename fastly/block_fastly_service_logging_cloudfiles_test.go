@@ -48,7 +48,6 @@ func TestResourceFastlyFlattenCloudfiles(t *testing.T) {
 					"public_key":         pgpPublicKey(t),
 					"format":             "%h %l %u %t \"%r\" %>s %b",
 					"format_version":     uint(2),
-					"gzip_level":         uint(0),
 					"message_type":       "classic",
 					"path":               "/",
 					"region":             "ORD",
@@ -82,7 +81,7 @@ func TestAccFastlyServiceVCL_logging_cloudfiles_basic(t *testing.T) {
 		User:              "user",
 		AccessKey:         "secret",
 		PublicKey:         pgpPublicKey(t),
-		Format:            "%h %l %u %t \"%r\" %>s %b",
+		Format:            appendNewLine("%h %l %u %t \"%r\" %>s %b"),
 		FormatVersion:     2,
 		GzipLevel:         0,
 		MessageType:       "classic",
@@ -102,7 +101,7 @@ func TestAccFastlyServiceVCL_logging_cloudfiles_basic(t *testing.T) {
 		User:              "userupdate",
 		AccessKey:         "secretupdate",
 		PublicKey:         pgpPublicKey(t),
-		Format:            "%h %l %u %t \"%r\" %>s %b %T",
+		Format:            appendNewLine("%h %l %u %t \"%r\" %>s %b %T"),
 		FormatVersion:     2,
 		GzipLevel:         1,
 		MessageType:       "blank",
@@ -122,7 +121,7 @@ func TestAccFastlyServiceVCL_logging_cloudfiles_basic(t *testing.T) {
 		User:              "user2",
 		AccessKey:         "secret2",
 		PublicKey:         pgpPublicKey(t),
-		Format:            "%h %l %u %t \"%r\" %>s %b",
+		Format:            appendNewLine("%h %l %u %t \"%r\" %>s %b"),
 		FormatVersion:     2,
 		GzipLevel:         0,
 		MessageType:       "classic",
@@ -42,18 +42,26 @@ func (h *DatadogServiceAttributeHandler) GetSchema() *schema.Schema {
 
 		// Optional fields
 		"region": {
-			Type:        schema.TypeString,
-			Optional:    true,
-			Default:     "US",
-			Description: "The region that log data will be sent to. One of `US` or `EU`. Defaults to `US` if undefined",
+			Type:             schema.TypeString,
+			Optional:         true,
+			Default:          "US",
+			Description:      "The region that log data will be sent to. One of `US` or `EU`. Defaults to `US` if undefined",
+			ValidateDiagFunc: validateLoggingDatadogRegion(),
 		},
 	}
 
 	if h.GetServiceMetadata().serviceType == ServiceTypeVCL {
 		blockAttributes["format"] = &schema.Schema{
-			Type:        schema.TypeString,
+			Type:             schema.TypeString,
+			Optional:         true,
+			Description:      "Apache-style string or VCL variables to use for log formatting.",
+			ValidateDiagFunc: validateLoggingFormat(),
+		}
+		blockAttributes["append_newline"] = &schema.Schema{
+			Type:        schema.TypeBool,
 			Optional:    true,
-			Description: "Apache-style string or VCL variables to use for log formatting.",
+			Default:     true,
+			Description: loggingAppendNewlineDescription,
 		}
 		blockAttributes["format_version"] = &schema.Schema{
 			Type:             schema.TypeInt,
@@ -112,6 +120,8 @@ func (h *DatadogServiceAttributeHandler) Read(_ context.Context, d *schema.Resou
 
 	for _, element := range dll {
 		element = h.pruneVCLLoggingAttributes(element)
+		h.restoreLoggingFormat(d, element)
+		h.restoreLoggingPlacement(d, element)
 	}
 
 	if err := d.Set(h.GetKey(), dll); err != nil {
@@ -140,8 +150,8 @@ func (h *DatadogServiceAttributeHandler) Update(_ context.Context, d *schema.Res
 	if v, ok := modified["region"]; ok {
 		opts.Region = gofastly.String(v.(string))
 	}
-	if v, ok := modified["format"]; ok {
-		opts.Format = gofastly.String(v.(string))
+	if v := h.modifiedLoggingFormat(resource, modified); v != nil {
+		opts.Format = v
 	}
 	if v, ok := modified["format_version"]; ok {
 		opts.FormatVersion = gofastly.Uint(uint(v.(int)))
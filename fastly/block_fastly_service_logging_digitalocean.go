@@ -55,10 +55,11 @@ func (h *DigitalOceanServiceAttributeHandler) GetSchema() *schema.Schema {
 
 		// Optional fields
 		"domain": {
-			Type:        schema.TypeString,
-			Optional:    true,
-			Description: "The domain of the DigitalOcean Spaces endpoint (default `nyc3.digitaloceanspaces.com`)",
-			Default:     "nyc3.digitaloceanspaces.com",
+			Type:             schema.TypeString,
+			Optional:         true,
+			Description:      "The domain of the DigitalOcean Spaces endpoint for the region the Space is in, without the bucket name, e.g. `sfo3.digitaloceanspaces.com` (default `nyc3.digitaloceanspaces.com`)",
+			Default:          "nyc3.digitaloceanspaces.com",
+			ValidateDiagFunc: validateLoggingDigitalOceanDomain(),
 		},
 
 		"public_key": {
@@ -77,6 +78,7 @@ func (h *DigitalOceanServiceAttributeHandler) GetSchema() *schema.Schema {
 		"period": {
 			Type:        schema.TypeInt,
 			Optional:    true,
+			Default:     3600,
 			Description: "How frequently log files are finalized so they can be available for reading (in seconds, default `3600`)",
 		},
 
@@ -109,9 +111,16 @@ func (h *DigitalOceanServiceAttributeHandler) GetSchema() *schema.Schema {
 
 	if h.GetServiceMetadata().serviceType == ServiceTypeVCL {
 		blockAttributes["format"] = &schema.Schema{
-			Type:        schema.TypeString,
+			Type:             schema.TypeString,
+			Optional:         true,
+			Description:      "Apache style log formatting.",
+			ValidateDiagFunc: validateLoggingFormat(),
+		}
+		blockAttributes["append_newline"] = &schema.Schema{
+			Type:        schema.TypeBool,
 			Optional:    true,
-			Description: "Apache style log formatting.",
+			Default:     true,
+			Description: loggingAppendNewlineDescription,
 		}
 		blockAttributes["format_version"] = &schema.Schema{
 			Type:             schema.TypeInt,
@@ -144,6 +153,10 @@ func (h *DigitalOceanServiceAttributeHandler) GetSchema() *schema.Schema {
 
 func (h *DigitalOceanServiceAttributeHandler) Create(_ context.Context, d *schema.ResourceData, resource map[string]interface {
 }, serviceVersion int, conn *gofastly.Client) error {
+	if err := checkLoggingCompression(resource); err != nil {
+		return err
+	}
+
 	opts := h.buildCreate(resource, d.Id(), serviceVersion)
 
 	log.Printf("[DEBUG] Fastly DigitalOcean Spaces logging addition opts: %#v", opts)
@@ -170,6 +183,8 @@ func (h *DigitalOceanServiceAttributeHandler) Read(_ context.Context, d *schema.
 
 	for _, element := range ell {
 		element = h.pruneVCLLoggingAttributes(element)
+		h.restoreLoggingFormat(d, element)
+		h.restoreLoggingPlacement(d, element)
 	}
 
 	if err := d.Set(h.GetKey(), ell); err != nil {
@@ -181,6 +196,10 @@ func (h *DigitalOceanServiceAttributeHandler) Read(_ context.Context, d *schema.
 
 func (h *DigitalOceanServiceAttributeHandler) Update(_ context.Context, d *schema.ResourceData, resource, modified map[string]interface {
 }, serviceVersion int, conn *gofastly.Client) error {
+	if err := checkLoggingCompression(resource); err != nil {
+		return err
+	}
+
 	opts := gofastly.UpdateDigitalOceanInput{
 		ServiceID:      d.Id(),
 		ServiceVersion: serviceVersion,
@@ -213,8 +232,8 @@ func (h *DigitalOceanServiceAttributeHandler) Update(_ context.Context, d *schem
 	if v, ok := modified["gzip_level"]; ok {
 		opts.GzipLevel = gofastly.Uint(uint(v.(int)))
 	}
-	if v, ok := modified["format"]; ok {
-		opts.Format = gofastly.String(v.(string))
+	if v := h.modifiedLoggingFormat(resource, modified); v != nil {
+		opts.Format = v
 	}
 	if v, ok := modified["format_version"]; ok {
 		opts.FormatVersion = gofastly.Uint(uint(v.(int)))
@@ -306,6 +325,7 @@ func flattenDigitalOcean(digitaloceanList []*gofastly.DigitalOcean) []map[string
 				delete(nll, k)
 			}
 		}
+		pruneLoggingCompression(nll)
 
 		lsl = append(lsl, nll)
 	}
@@ -50,7 +50,6 @@ func TestResourceFastlyFlattenDigitalOcean(t *testing.T) {
 					"path":               "/",
 					"period":             uint(3600),
 					"timestamp_format":   "%Y-%m-%dT%H:%M:%S.000",
-					"gzip_level":         uint(0),
 					"format":             "%h %l %u %t \"%r\" %>s %b",
 					"format_version":     uint(2),
 					"message_type":       "classic",
@@ -86,7 +85,7 @@ func TestAccFastlyServiceVCL_logging_digitalocean_basic(t *testing.T) {
 		Path:              "/",
 		Period:            3600,
 		TimestampFormat:   "%Y-%m-%dT%H:%M:%S.000",
-		Format:            "%h %l %u %t \"%r\" %>s %b",
+		Format:            appendNewLine("%h %l %u %t \"%r\" %>s %b"),
 		FormatVersion:     2,
 		MessageType:       "classic",
 		Placement:         "none",
@@ -104,7 +103,7 @@ func TestAccFastlyServiceVCL_logging_digitalocean_basic(t *testing.T) {
 		PublicKey:         pgpPublicKey(t),
 		Path:              "new/",
 		Period:            3601,
-		Format:            "%h %l %u %t \"%r\" %>s %b %T",
+		Format:            appendNewLine("%h %l %u %t \"%r\" %>s %b %T"),
 		TimestampFormat:   "%Y-%m-%dT%H:%M:%S.000",
 		GzipLevel:         2,
 		FormatVersion:     2,
@@ -123,7 +122,7 @@ func TestAccFastlyServiceVCL_logging_digitalocean_basic(t *testing.T) {
 		PublicKey:         pgpPublicKey(t),
 		Path:              "two/",
 		Period:            3600,
-		Format:            "%h %l %u %t \"%r\" %>s %b",
+		Format:            appendNewLine("%h %l %u %t \"%r\" %>s %b"),
 		TimestampFormat:   "%Y-%m-%dT%H:%M:%S.000",
 		GzipLevel:         0,
 		FormatVersion:     2,
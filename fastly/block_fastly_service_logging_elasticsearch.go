@@ -42,7 +42,7 @@ func (h *ElasticSearchServiceAttributeHandler) GetSchema() *schema.Schema {
 		"index": {
 			Type:        schema.TypeString,
 			Required:    true,
-			Description: "The name of the Elasticsearch index to send documents (logs) to",
+			Description: "The name of the Elasticsearch index to send documents (logs) to. Supports [strftime](https://www.man7.org/linux/man-pages/man3/strftime.3.html) variables inside braces prefixed with `#`, e.g. `logs-#{%F}` for an index per day named after the date as `YYYY-MM-DD`. The value is sent to Fastly as is and interpolated when logs are sent",
 		},
 
 		// Optional fields
@@ -66,17 +66,19 @@ func (h *ElasticSearchServiceAttributeHandler) GetSchema() *schema.Schema {
 		},
 
 		"request_max_entries": {
-			Type:        schema.TypeInt,
-			Optional:    true,
-			Default:     0,
-			Description: "The maximum number of bytes sent in one request. Defaults to `0` for unbounded",
+			Type:             schema.TypeInt,
+			Optional:         true,
+			Default:          0,
+			Description:      "The maximum number of logs sent in one request. Defaults to `0` for unbounded",
+			ValidateDiagFunc: validateLoggingRequestLimit(),
 		},
 
 		"request_max_bytes": {
-			Type:        schema.TypeInt,
-			Optional:    true,
-			Default:     0,
-			Description: "The maximum number of logs sent in one request. Defaults to `0` for unbounded",
+			Type:             schema.TypeInt,
+			Optional:         true,
+			Default:          0,
+			Description:      "The maximum number of bytes sent in one request. Defaults to `0` for unbounded",
+			ValidateDiagFunc: validateLoggingRequestLimit(),
 		},
 
 		"tls_ca_cert": {
@@ -110,10 +112,17 @@ func (h *ElasticSearchServiceAttributeHandler) GetSchema() *schema.Schema {
 
 	if h.GetServiceMetadata().serviceType == ServiceTypeVCL {
 		blockAttributes["format"] = &schema.Schema{
-			Type:        schema.TypeString,
+			Type:             schema.TypeString,
+			Optional:         true,
+			Default:          "%h %l %u %t \"%r\" %>s %b",
+			Description:      "Apache-style string or VCL variables to use for log formatting.",
+			ValidateDiagFunc: validateLoggingFormat(),
+		}
+		blockAttributes["append_newline"] = &schema.Schema{
+			Type:        schema.TypeBool,
 			Optional:    true,
-			Default:     "%h %l %u %t \"%r\" %>s %b",
-			Description: "Apache-style string or VCL variables to use for log formatting.",
+			Default:     true,
+			Description: loggingAppendNewlineDescription,
 		}
 		blockAttributes["format_version"] = &schema.Schema{
 			Type:             schema.TypeInt,
@@ -172,6 +181,8 @@ func (h *ElasticSearchServiceAttributeHandler) Read(_ context.Context, d *schema
 
 	for _, element := range ell {
 		element = h.pruneVCLLoggingAttributes(element)
+		h.restoreLoggingFormat(d, element)
+		h.restoreLoggingPlacement(d, element)
 	}
 
 	if err := d.Set(h.GetKey(), ell); err != nil {
@@ -196,8 +207,8 @@ func (h *ElasticSearchServiceAttributeHandler) Update(_ context.Context, d *sche
 	if v, ok := modified["response_condition"]; ok {
 		opts.ResponseCondition = gofastly.String(v.(string))
 	}
-	if v, ok := modified["format"]; ok {
-		opts.Format = gofastly.String(v.(string))
+	if v := h.modifiedLoggingFormat(resource, modified); v != nil {
+		opts.Format = v
 	}
 	if v, ok := modified["index"]; ok {
 		opts.Index = gofastly.String(v.(string))
@@ -22,7 +22,7 @@ func TestResourceFastlyFlattenElasticsearch(t *testing.T) {
 				{
 					ServiceVersion:    1,
 					Name:              "elasticsearch-endpoint",
-					Index:             "index",
+					Index:             "logs-#{%F}",
 					URL:               "https://logs.example.com",
 					Pipeline:          "my-pipeline-id",
 					RequestMaxEntries: 10,
@@ -42,7 +42,7 @@ func TestResourceFastlyFlattenElasticsearch(t *testing.T) {
 			local: []map[string]interface{}{
 				{
 					"name":                "elasticsearch-endpoint",
-					"index":               "index",
+					"index":               "logs-#{%F}",
 					"url":                 "https://logs.example.com",
 					"pipeline":            "my-pipeline-id",
 					"user":                "user",
@@ -83,7 +83,7 @@ func TestAccFastlyServiceVCL_logging_elasticsearch_basic(t *testing.T) {
 		RequestMaxBytes:   0,
 		RequestMaxEntries: 0,
 		FormatVersion:     2,
-		Format:            "%h %l %u %t \"%r\" %>s %b",
+		Format:            appendNewLine("%h %l %u %t \"%r\" %>s %b"),
 		User:              "user",
 		Password:          "password",
 		Pipeline:          "my-pipeline",
@@ -103,7 +103,7 @@ func TestAccFastlyServiceVCL_logging_elasticsearch_basic(t *testing.T) {
 		RequestMaxBytes:   0,
 		RequestMaxEntries: 0,
 		FormatVersion:     2,
-		Format:            "%h %l %u %t \"%r\" %>s %b %T",
+		Format:            appendNewLine("%h %l %u %t \"%r\" %>s %b %T"),
 		User:              "newuser",
 		Password:          "newpassword",
 		Pipeline:          "my-new-pipeline",
@@ -123,7 +123,7 @@ func TestAccFastlyServiceVCL_logging_elasticsearch_basic(t *testing.T) {
 		RequestMaxBytes:   1000,
 		RequestMaxEntries: 0,
 		FormatVersion:     2,
-		Format:            "%h %l %u %t \"%r\" %>s %b",
+		Format:            appendNewLine("%h %l %u %t \"%r\" %>s %b"),
 		User:              "username",
 		Password:          "secret-password",
 		Pipeline:          "my-new-pipeline",
@@ -60,10 +60,11 @@ func (h *FTPServiceAttributeHandler) GetSchema() *schema.Schema {
 
 		// Optional fields
 		"port": {
-			Type:        schema.TypeInt,
-			Optional:    true,
-			Default:     21,
-			Description: "The port number. Default: `21`",
+			Type:             schema.TypeInt,
+			Optional:         true,
+			Default:          21,
+			Description:      "The port number. Default: `21`",
+			ValidateDiagFunc: validatePortNumber(),
 		},
 
 		"period": {
@@ -111,9 +112,16 @@ func (h *FTPServiceAttributeHandler) GetSchema() *schema.Schema {
 
 	if h.GetServiceMetadata().serviceType == ServiceTypeVCL {
 		blockAttributes["format"] = &schema.Schema{
-			Type:        schema.TypeString,
+			Type:             schema.TypeString,
+			Optional:         true,
+			Description:      "Apache-style string or VCL variables to use for log formatting.",
+			ValidateDiagFunc: validateLoggingFormat(),
+		}
+		blockAttributes["append_newline"] = &schema.Schema{
+			Type:        schema.TypeBool,
 			Optional:    true,
-			Description: "Apache-style string or VCL variables to use for log formatting.",
+			Default:     true,
+			Description: loggingAppendNewlineDescription,
 		}
 		blockAttributes["format_version"] = &schema.Schema{
 			Type:             schema.TypeInt,
@@ -146,6 +154,10 @@ func (h *FTPServiceAttributeHandler) GetSchema() *schema.Schema {
 
 func (h *FTPServiceAttributeHandler) Create(_ context.Context, d *schema.ResourceData, resource map[string]interface {
 }, serviceVersion int, conn *gofastly.Client) error {
+	if err := checkLoggingCompression(resource); err != nil {
+		return err
+	}
+
 	opts := h.buildCreate(resource, d.Id(), serviceVersion)
 
 	log.Printf("[DEBUG] Fastly FTP logging addition opts: %#v", opts)
@@ -172,6 +184,8 @@ func (h *FTPServiceAttributeHandler) Read(_ context.Context, d *schema.ResourceD
 
 	for _, element := range ell {
 		element = h.pruneVCLLoggingAttributes(element)
+		h.restoreLoggingFormat(d, element)
+		h.restoreLoggingPlacement(d, element)
 	}
 
 	if err := d.Set(h.GetKey(), ell); err != nil {
@@ -182,6 +196,10 @@ func (h *FTPServiceAttributeHandler) Read(_ context.Context, d *schema.ResourceD
 
 func (h *FTPServiceAttributeHandler) Update(_ context.Context, d *schema.ResourceData, resource, modified map[string]interface {
 }, serviceVersion int, conn *gofastly.Client) error {
+	if err := checkLoggingCompression(resource); err != nil {
+		return err
+	}
+
 	opts := gofastly.UpdateFTPInput{
 		ServiceID:      d.Id(),
 		ServiceVersion: serviceVersion,
@@ -214,8 +232,8 @@ func (h *FTPServiceAttributeHandler) Update(_ context.Context, d *schema.Resourc
 	if v, ok := modified["period"]; ok {
 		opts.Period = gofastly.Uint(uint(v.(int)))
 	}
-	if v, ok := modified["format"]; ok {
-		opts.Format = gofastly.String(v.(string))
+	if v := h.modifiedLoggingFormat(resource, modified); v != nil {
+		opts.Format = v
 	}
 	if v, ok := modified["format_version"]; ok {
 		opts.FormatVersion = gofastly.Uint(uint(v.(int)))
@@ -309,6 +327,7 @@ func flattenFTP(ftpList []*gofastly.FTP) []map[string]interface{} {
 				delete(nfl, k)
 			}
 		}
+		pruneLoggingCompression(nfl)
 
 		fsl = append(fsl, nfl)
 	}
@@ -48,7 +48,6 @@ func TestResourceFastlyFlattenFTP(t *testing.T) {
 					"path":              "/path",
 					"period":            uint(3600),
 					"port":              uint(21),
-					"gzip_level":        uint8(0),
 					"format_version":    uint(2),
 					"format":            "%h %l %u %t \"%r\" %>s %b",
 					"timestamp_format":  "%Y-%m-%dT%H:%M:%S.000",
@@ -84,7 +83,7 @@ func TestAccFastlyServiceVCL_logging_ftp_basic(t *testing.T) {
 		Port:             27,
 		Period:           3600,
 		TimestampFormat:  "%Y-%m-%dT%H:%M:%S.000",
-		Format:           "%h %l %u %t \"%r\" %>s %b",
+		Format:           appendNewLine("%h %l %u %t \"%r\" %>s %b"),
 		FormatVersion:    2,
 		Placement:        "none",
 		MessageType:      "classic",
@@ -103,7 +102,7 @@ func TestAccFastlyServiceVCL_logging_ftp_basic(t *testing.T) {
 		Port:            21,
 		Period:          3600,
 		TimestampFormat: "%Y-%m-%dT%H:%M:%S.000",
-		Format:          "%h %l %u %t \"%r\" %>s %b %T",
+		Format:          appendNewLine("%h %l %u %t \"%r\" %>s %b %T"),
 		FormatVersion:   2,
 		Placement:       "waf_debug",
 		MessageType:     "classic",
@@ -120,7 +119,7 @@ func TestAccFastlyServiceVCL_logging_ftp_basic(t *testing.T) {
 		Port:             21,
 		Period:           360,
 		TimestampFormat:  "%Y-%m-%dT%H:%M:%S.000",
-		Format:           "%h %l %u %t \"%r\" %>s %b",
+		Format:           appendNewLine("%h %l %u %t \"%r\" %>s %b"),
 		FormatVersion:    2,
 		Placement:        "none",
 		MessageType:      "classic",
@@ -44,11 +44,12 @@ func (h *GCSLoggingServiceAttributeHandler) GetSchema() *schema.Schema {
 			Description: "The name of the bucket in which to store the logs",
 		},
 		"secret_key": {
-			Type:        schema.TypeString,
-			Optional:    true,
-			DefaultFunc: schema.EnvDefaultFunc("FASTLY_GCS_SECRET_KEY", ""),
-			Description: "The secret key associated with the target gcs bucket on your account. You may optionally provide this secret via an environment variable, `FASTLY_GCS_SECRET_KEY`. A typical format for the key is PEM format, containing actual newline characters where required",
-			Sensitive:   true,
+			Type:             schema.TypeString,
+			Optional:         true,
+			DefaultFunc:      schema.EnvDefaultFunc("FASTLY_GCS_SECRET_KEY", ""),
+			Description:      "The secret key associated with the target gcs bucket on your account. You may optionally provide this secret via an environment variable, `FASTLY_GCS_SECRET_KEY`. A typical format for the key is PEM format, containing actual newline characters where required. If the service account JSON is given instead, it must contain the `client_email` and `private_key` fields",
+			Sensitive:        true,
+			ValidateDiagFunc: validateGCPSecretKey(),
 		},
 		// Optional fields
 		"path": {
@@ -91,10 +92,17 @@ func (h *GCSLoggingServiceAttributeHandler) GetSchema() *schema.Schema {
 
 	if h.GetServiceMetadata().serviceType == ServiceTypeVCL {
 		blockAttributes["format"] = &schema.Schema{
-			Type:        schema.TypeString,
+			Type:             schema.TypeString,
+			Optional:         true,
+			Default:          `%h %l %u %t "%r" %>s %b`,
+			Description:      "Apache-style string or VCL variables to use for log formatting",
+			ValidateDiagFunc: validateLoggingFormat(),
+		}
+		blockAttributes["append_newline"] = &schema.Schema{
+			Type:        schema.TypeBool,
 			Optional:    true,
-			Default:     `%h %l %u %t "%r" %>s %b`,
-			Description: "Apache-style string or VCL variables to use for log formatting",
+			Default:     true,
+			Description: loggingAppendNewlineDescription,
 		}
 		blockAttributes["format_version"] = &schema.Schema{
 			Type:             schema.TypeInt,
@@ -128,6 +136,10 @@ func (h *GCSLoggingServiceAttributeHandler) GetSchema() *schema.Schema {
 
 func (h *GCSLoggingServiceAttributeHandler) Create(_ context.Context, d *schema.ResourceData, resource map[string]interface {
 }, serviceVersion int, conn *gofastly.Client) error {
+	if err := checkLoggingCompression(resource); err != nil {
+		return err
+	}
+
 	var vla = h.getVCLLoggingAttributes(resource)
 	opts := gofastly.CreateGCSInput{
 		ServiceID:         d.Id(),
@@ -147,7 +159,9 @@ func (h *GCSLoggingServiceAttributeHandler) Create(_ context.Context, d *schema.
 		Placement:         vla.placement,
 	}
 
-	log.Printf("[DEBUG] Create GCS Opts: %#v", opts)
+	logOpts := opts
+	logOpts.SecretKey = "<sensitive>"
+	log.Printf("[DEBUG] Create GCS Opts: %#v", logOpts)
 	_, err := conn.CreateGCS(&opts)
 	if err != nil {
 		return err
@@ -170,6 +184,8 @@ func (h *GCSLoggingServiceAttributeHandler) Read(_ context.Context, d *schema.Re
 
 	for _, element := range gcsl {
 		element = h.pruneVCLLoggingAttributes(element)
+		h.restoreLoggingFormat(d, element)
+		h.restoreLoggingPlacement(d, element)
 	}
 
 	if err := d.Set(h.GetKey(), gcsl); err != nil {
@@ -181,6 +197,10 @@ func (h *GCSLoggingServiceAttributeHandler) Read(_ context.Context, d *schema.Re
 
 func (h *GCSLoggingServiceAttributeHandler) Update(_ context.Context, d *schema.ResourceData, resource, modified map[string]interface {
 }, serviceVersion int, conn *gofastly.Client) error {
+	if err := checkLoggingCompression(resource); err != nil {
+		return err
+	}
+
 	opts := gofastly.UpdateGCSInput{
 		ServiceID:      d.Id(),
 		ServiceVersion: serviceVersion,
@@ -216,8 +236,8 @@ func (h *GCSLoggingServiceAttributeHandler) Update(_ context.Context, d *schema.
 	if v, ok := modified["gzip_level"]; ok {
 		opts.GzipLevel = gofastly.Uint8(uint8(v.(int)))
 	}
-	if v, ok := modified["format"]; ok {
-		opts.Format = gofastly.String(v.(string))
+	if v := h.modifiedLoggingFormat(resource, modified); v != nil {
+		opts.Format = v
 	}
 	if v, ok := modified["message_type"]; ok {
 		opts.MessageType = gofastly.String(v.(string))
@@ -287,6 +307,7 @@ func flattenGCS(gcsList []*gofastly.GCS) []map[string]interface{} {
 				delete(GCSMapString, k)
 			}
 		}
+		pruneLoggingCompression(GCSMapString)
 
 		GCSList = append(GCSList, GCSMapString)
 	}
@@ -46,7 +46,6 @@ func TestResourceFastlyFlattenGCS(t *testing.T) {
 					"format":            "log format",
 					"format_version":    uint(2),
 					"period":            3600,
-					"gzip_level":        0,
 					"compression_codec": "zstd",
 				},
 			},
@@ -41,11 +41,12 @@ func (h *GooglePubSubServiceAttributeHandler) GetSchema() *schema.Schema {
 		},
 
 		"secret_key": {
-			Type:        schema.TypeString,
-			Required:    true,
-			Description: "Your Google Cloud Platform account secret key. The `private_key` field in your service account authentication JSON. You may optionally provide this secret via an environment variable, `FASTLY_GOOGLE_PUBSUB_SECRET_KEY`.",
-			DefaultFunc: schema.EnvDefaultFunc("FASTLY_GOOGLE_PUBSUB_SECRET_KEY", ""),
-			Sensitive:   true,
+			Type:             schema.TypeString,
+			Required:         true,
+			Description:      "Your Google Cloud Platform account secret key. The `private_key` field in your service account authentication JSON. You may optionally provide this secret via an environment variable, `FASTLY_GOOGLE_PUBSUB_SECRET_KEY`. A multi-line PEM key, e.g. from a heredoc or `file()`, is sent as given. If the service account JSON is given instead, it must contain the `client_email` and `private_key` fields",
+			DefaultFunc:      schema.EnvDefaultFunc("FASTLY_GOOGLE_PUBSUB_SECRET_KEY", ""),
+			Sensitive:        true,
+			ValidateDiagFunc: validateGCPSecretKey(),
 		},
 
 		"project_id": {
@@ -63,9 +64,16 @@ func (h *GooglePubSubServiceAttributeHandler) GetSchema() *schema.Schema {
 
 	if h.GetServiceMetadata().serviceType == ServiceTypeVCL {
 		blockAttributes["format"] = &schema.Schema{
-			Type:        schema.TypeString,
+			Type:             schema.TypeString,
+			Optional:         true,
+			Description:      "Apache style log formatting.",
+			ValidateDiagFunc: validateLoggingFormat(),
+		}
+		blockAttributes["append_newline"] = &schema.Schema{
+			Type:        schema.TypeBool,
 			Optional:    true,
-			Description: "Apache style log formatting.",
+			Default:     true,
+			Description: loggingAppendNewlineDescription,
 		}
 		blockAttributes["format_version"] = &schema.Schema{
 			Type:             schema.TypeInt,
@@ -124,6 +132,8 @@ func (h *GooglePubSubServiceAttributeHandler) Read(_ context.Context, d *schema.
 
 	for _, element := range googlepubsubLogList {
 		element = h.pruneVCLLoggingAttributes(element)
+		h.restoreLoggingFormat(d, element)
+		h.restoreLoggingPlacement(d, element)
 	}
 
 	if err := d.Set(h.GetKey(), googlepubsubLogList); err != nil {
@@ -161,8 +171,8 @@ func (h *GooglePubSubServiceAttributeHandler) Update(_ context.Context, d *schem
 	if v, ok := modified["format_version"]; ok {
 		opts.FormatVersion = gofastly.Uint(uint(v.(int)))
 	}
-	if v, ok := modified["format"]; ok {
-		opts.Format = gofastly.String(v.(string))
+	if v := h.modifiedLoggingFormat(resource, modified); v != nil {
+		opts.Format = v
 	}
 	if v, ok := modified["response_condition"]; ok {
 		opts.ResponseCondition = gofastly.String(v.(string))
@@ -9,6 +9,7 @@ import (
 
 	gofastly "github.com/fastly/go-fastly/v6/fastly"
 	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -137,6 +138,11 @@ func TestSecretKeySchemaDefaultFunc(t *testing.T) {
 	if result != mockValue {
 		t.Fatalf("Error matching:\nexpected: %#v\ngot: %#v", mockValue, result)
 	}
+
+	// A multi-line PEM with a trailing newline, as produced by a heredoc, is accepted
+	if diags := loggingResourceSchema["secret_key"].ValidateDiagFunc(mockValue, cty.GetAttrPath("secret_key")); diags.HasError() {
+		t.Fatalf("Unexpected error validating a heredoc secret_key: %#v", diags)
+	}
 }
 
 func TestAccFastlyServiceVCL_googlepubsublogging_basic(t *testing.T) {
@@ -152,7 +158,7 @@ func TestAccFastlyServiceVCL_googlepubsublogging_basic(t *testing.T) {
 		ProjectID:         "project-id",
 		Topic:             "topic",
 		ResponseCondition: "response_condition_test",
-		Format:            `%a %l %u %t %m %U%q %H %>s %b %T`,
+		Format:            appendNewLine(`%a %l %u %t %m %U%q %H %>s %b %T`),
 		FormatVersion:     2,
 		Placement:         "none",
 	}
@@ -165,7 +171,7 @@ func TestAccFastlyServiceVCL_googlepubsublogging_basic(t *testing.T) {
 		ProjectID:         "new-project-id",
 		Topic:             "newtopic",
 		ResponseCondition: "response_condition_test",
-		Format:            `%a %l %u %t %m %U%q %H %>s %b %T`,
+		Format:            appendNewLine(`%a %l %u %t %m %U%q %H %>s %b %T`),
 		FormatVersion:     2,
 		Placement:         "waf_debug",
 	}
@@ -178,7 +184,7 @@ func TestAccFastlyServiceVCL_googlepubsublogging_basic(t *testing.T) {
 		ProjectID:         "project-id",
 		Topic:             "topicb",
 		ResponseCondition: "response_condition_test",
-		Format:            `%a %l %u %t %m %U%q %H %>s %b %T`,
+		Format:            appendNewLine(`%a %l %u %t %m %U%q %H %>s %b %T`),
 		FormatVersion:     2,
 		Placement:         "none",
 	}
@@ -41,17 +41,26 @@ func (h *HerokuServiceAttributeHandler) GetSchema() *schema.Schema {
 		},
 
 		"url": {
-			Type:        schema.TypeString,
-			Required:    true,
-			Description: "The URL to stream logs to",
+			Type:             schema.TypeString,
+			Required:         true,
+			Sensitive:        true,
+			Description:      "The URL to stream logs to. Must use `https`. This is marked as sensitive as Logplex URLs embed the token",
+			ValidateDiagFunc: validateLoggingHerokuURL(),
 		},
 	}
 
 	if h.GetServiceMetadata().serviceType == ServiceTypeVCL {
 		blockAttributes["format"] = &schema.Schema{
-			Type:        schema.TypeString,
+			Type:             schema.TypeString,
+			Optional:         true,
+			Description:      "Apache-style string or VCL variables to use for log formatting.",
+			ValidateDiagFunc: validateLoggingFormat(),
+		}
+		blockAttributes["append_newline"] = &schema.Schema{
+			Type:        schema.TypeBool,
 			Optional:    true,
-			Description: "Apache-style string or VCL variables to use for log formatting.",
+			Default:     true,
+			Description: loggingAppendNewlineDescription,
 		}
 		blockAttributes["format_version"] = &schema.Schema{
 			Type:             schema.TypeInt,
@@ -110,6 +119,8 @@ func (h *HerokuServiceAttributeHandler) Read(_ context.Context, d *schema.Resour
 
 	for _, element := range ell {
 		element = h.pruneVCLLoggingAttributes(element)
+		h.restoreLoggingFormat(d, element)
+		h.restoreLoggingPlacement(d, element)
 	}
 
 	if err := d.Set(h.GetKey(), ell); err != nil {
@@ -132,8 +143,8 @@ func (h *HerokuServiceAttributeHandler) Update(_ context.Context, d *schema.Reso
 	// materializes as a panic (yay) and so it's only at runtime we discover
 	// this and so we've updated the below code to convert the type asserted
 	// int into a uint before passing the value to gofastly.Uint().
-	if v, ok := modified["format"]; ok {
-		opts.Format = gofastly.String(v.(string))
+	if v := h.modifiedLoggingFormat(resource, modified); v != nil {
+		opts.Format = v
 	}
 	if v, ok := modified["format_version"]; ok {
 		opts.FormatVersion = gofastly.Uint(uint(v.(int)))
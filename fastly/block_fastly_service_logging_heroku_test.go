@@ -63,7 +63,7 @@ func TestAccFastlyServiceVCL_logging_heroku_basic(t *testing.T) {
 		URL:            "https://example.com",
 		Token:          "s3cr3t",
 		FormatVersion:  2,
-		Format:         "%h %l %u %t \"%r\" %>s %b",
+		Format:         appendNewLine("%h %l %u %t \"%r\" %>s %b"),
 	}
 
 	log1_after_update := gofastly.Heroku{
@@ -74,7 +74,7 @@ func TestAccFastlyServiceVCL_logging_heroku_basic(t *testing.T) {
 		ResponseCondition: "response_condition_test",
 		Token:             "secret",
 		FormatVersion:     2,
-		Format:            "%h %l %u %t \"%r\" %>s %b %T",
+		Format:            appendNewLine("%h %l %u %t \"%r\" %>s %b %T"),
 	}
 
 	log2 := gofastly.Heroku{
@@ -83,7 +83,7 @@ func TestAccFastlyServiceVCL_logging_heroku_basic(t *testing.T) {
 		URL:            "https://new.example.com",
 		Token:          "another-token",
 		FormatVersion:  2,
-		Format:         "%h %l %u %t \"%r\" %>s %b",
+		Format:         appendNewLine("%h %l %u %t \"%r\" %>s %b"),
 	}
 
 	resource.ParallelTest(t, resource.TestCase{
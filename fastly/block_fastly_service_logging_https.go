@@ -42,15 +42,19 @@ func (h *HTTPSLoggingServiceAttributeHandler) GetSchema() *schema.Schema {
 
 		// Optional fields
 		"request_max_entries": {
-			Type:        schema.TypeInt,
-			Optional:    true,
-			Description: "The maximum number of logs sent in one request",
+			Type:             schema.TypeInt,
+			Optional:         true,
+			Default:          0,
+			Description:      "The maximum number of logs sent in one request. Lower this if the collector times out on large batches. Defaults to `0` for no limit",
+			ValidateDiagFunc: validateLoggingRequestLimit(),
 		},
 
 		"request_max_bytes": {
-			Type:        schema.TypeInt,
-			Optional:    true,
-			Description: "The maximum number of bytes sent in one request",
+			Type:             schema.TypeInt,
+			Optional:         true,
+			Default:          0,
+			Description:      "The maximum number of bytes sent in one request. Defaults to `0` for no limit",
+			ValidateDiagFunc: validateLoggingRequestLimit(),
 		},
 
 		"content_type": {
@@ -72,20 +76,20 @@ func (h *HTTPSLoggingServiceAttributeHandler) GetSchema() *schema.Schema {
 		},
 
 		"method": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "POST",
-			Description:  "HTTP method used for request. Can be either `POST` or `PUT`. Default `POST`",
-			ValidateFunc: validation.StringInSlice([]string{"POST", "PUT"}, false),
+			Type:             schema.TypeString,
+			Optional:         true,
+			Default:          "POST",
+			Description:      "HTTP method used for request. Can be either `POST` or `PUT`. Default `POST`",
+			ValidateDiagFunc: validateLoggingHTTPSMethod(),
 		},
 
 		// NOTE: The `json_format` field's documented type is string, but it should likely be an integer.
 		"json_format": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Default:      "0",
-			Description:  "Formats log entries as JSON. Can be either disabled (`0`), array of json (`1`), or newline delimited json (`2`)",
-			ValidateFunc: validation.StringInSlice([]string{"0", "1", "2"}, false),
+			Type:             schema.TypeString,
+			Optional:         true,
+			Default:          "0",
+			Description:      "Formats log entries as JSON. Can be either disabled (`0`), array of json (`1`), or newline delimited json (`2`)",
+			ValidateDiagFunc: validateLoggingHTTPSJSONFormat(),
 		},
 
 		"tls_ca_cert": {
@@ -127,9 +131,16 @@ func (h *HTTPSLoggingServiceAttributeHandler) GetSchema() *schema.Schema {
 
 	if h.GetServiceMetadata().serviceType == ServiceTypeVCL {
 		blockAttributes["format"] = &schema.Schema{
-			Type:        schema.TypeString,
+			Type:             schema.TypeString,
+			Optional:         true,
+			Description:      "Apache-style string or VCL variables to use for log formatting.",
+			ValidateDiagFunc: validateLoggingFormat(),
+		}
+		blockAttributes["append_newline"] = &schema.Schema{
+			Type:        schema.TypeBool,
 			Optional:    true,
-			Description: "Apache-style string or VCL variables to use for log formatting.",
+			Default:     true,
+			Description: loggingAppendNewlineDescription,
 		}
 		blockAttributes["format_version"] = &schema.Schema{
 			Type:             schema.TypeInt,
@@ -188,6 +199,8 @@ func (h *HTTPSLoggingServiceAttributeHandler) Read(_ context.Context, d *schema.
 
 	for _, element := range hll {
 		element = h.pruneVCLLoggingAttributes(element)
+		h.restoreLoggingFormat(d, element)
+		h.restoreLoggingPlacement(d, element)
 	}
 
 	if err := d.Set(h.GetKey(), hll); err != nil {
@@ -213,8 +226,8 @@ func (h *HTTPSLoggingServiceAttributeHandler) Update(_ context.Context, d *schem
 	if v, ok := modified["response_condition"]; ok {
 		opts.ResponseCondition = gofastly.String(v.(string))
 	}
-	if v, ok := modified["format"]; ok {
-		opts.Format = gofastly.String(v.(string))
+	if v := h.modifiedLoggingFormat(resource, modified); v != nil {
+		opts.Format = v
 	}
 	if v, ok := modified["url"]; ok {
 		opts.URL = gofastly.String(v.(string))
@@ -9,6 +9,7 @@ import (
 	gofastly "github.com/fastly/go-fastly/v6/fastly"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
@@ -53,6 +54,44 @@ func TestResourceFastlyFlattenHTTPS(t *testing.T) {
 
 }
 
+func TestResourceFastlyHTTPSBuildCreateRequestLimits(t *testing.T) {
+	h := &HTTPSLoggingServiceAttributeHandler{
+		&DefaultServiceAttributeHandler{key: "logging_https", serviceMetadata: ServiceMetadata{ServiceTypeVCL}},
+	}
+
+	for _, c := range []struct {
+		name            string
+		block           map[string]interface{}
+		expectedEntries uint
+		expectedBytes   uint
+	}{
+		{
+			name:  "defaults",
+			block: map[string]interface{}{"name": "httpslogger", "url": "https://example.com/logs"},
+		},
+		{
+			name:            "small batches",
+			block:           map[string]interface{}{"name": "httpslogger", "url": "https://example.com/logs", "request_max_entries": 50, "request_max_bytes": 1000},
+			expectedEntries: 50,
+			expectedBytes:   1000,
+		},
+	} {
+		t.Run(c.name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, resourceServiceVCL().Schema, map[string]interface{}{
+				"name":          "test",
+				"logging_https": []interface{}{c.block},
+			})
+			opts := h.buildCreate(d.Get("logging_https").(*schema.Set).List()[0], "123", 1)
+			if opts.RequestMaxEntries != c.expectedEntries {
+				t.Errorf("expected request_max_entries %d, got %d", c.expectedEntries, opts.RequestMaxEntries)
+			}
+			if opts.RequestMaxBytes != c.expectedBytes {
+				t.Errorf("expected request_max_bytes %d, got %d", c.expectedBytes, opts.RequestMaxBytes)
+			}
+		})
+	}
+}
+
 func TestAccFastlyServiceVCL_httpslogging_basic(t *testing.T) {
 	var service gofastly.ServiceDetail
 	name := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
@@ -64,7 +103,7 @@ func TestAccFastlyServiceVCL_httpslogging_basic(t *testing.T) {
 		URL:            "https://example.com/logs/1",
 		Method:         "PUT",
 
-		Format:            "%a %l %u %t %m %U%q %H %>s %b %T",
+		Format:            appendNewLine("%a %l %u %t %m %U%q %H %>s %b %T"),
 		RequestMaxEntries: 0,
 		RequestMaxBytes:   0,
 		MessageType:       "blank",
@@ -78,8 +117,8 @@ func TestAccFastlyServiceVCL_httpslogging_basic(t *testing.T) {
 		URL:            "https://example.com/logs/1",
 		Method:         "POST",
 
-		Format:            "%a %l %u %t %m %U%q %H %>s %b",
-		RequestMaxEntries: 0,
+		Format:            appendNewLine("%a %l %u %t %m %U%q %H %>s %b"),
+		RequestMaxEntries: 100,
 		RequestMaxBytes:   0,
 		MessageType:       "blank",
 		FormatVersion:     2,
@@ -92,8 +131,8 @@ func TestAccFastlyServiceVCL_httpslogging_basic(t *testing.T) {
 		URL:            "https://example.com/logs/2",
 		Method:         "POST",
 
-		Format:            "%a %l %u %t %m %U%q %H %>s %b %T",
-		RequestMaxEntries: 0,
+		Format:            appendNewLine("%a %l %u %t %m %U%q %H %>s %b %T"),
+		RequestMaxEntries: 50,
 		RequestMaxBytes:   1000,
 		MessageType:       "blank",
 		FormatVersion:     2,
@@ -293,17 +332,19 @@ resource "fastly_service_vcl" "foo" {
 
 	logging_https {
 		name               = "httpslogger"
-		format             = "%%a %%l %%u %%t %%m %%U%%q %%H %%>s %%b"
-		method             = "POST"
-		url                = "https://example.com/logs/1"
+		format              = "%%a %%l %%u %%t %%m %%U%%q %%H %%>s %%b"
+		method              = "POST"
+		url                 = "https://example.com/logs/1"
+		request_max_entries = 100
 	}
 
 	logging_https {
 		name               = "httpslogger2"
 		format             = "%%a %%l %%u %%t %%m %%U%%q %%H %%>s %%b %%T"
 		method             = "POST"
-		url                = "https://example.com/logs/2"
-		request_max_bytes  = 1000
+		url                 = "https://example.com/logs/2"
+		request_max_entries = 50
+		request_max_bytes   = 1000
 	}
 	force_destroy = true
 }`, name, domain)
@@ -40,29 +40,32 @@ func (h *KafkaServiceAttributeHandler) GetSchema() *schema.Schema {
 		},
 
 		"brokers": {
-			Type:        schema.TypeString,
-			Required:    true,
-			Description: "A comma-separated list of IP addresses or hostnames of Kafka brokers",
+			Type:             schema.TypeString,
+			Required:         true,
+			Description:      "A comma-separated list of IP addresses or hostnames of Kafka brokers, each in the form `host:port`",
+			ValidateDiagFunc: validateKafkaBrokers(),
 		},
 
 		// Optional
 		"compression_codec": {
-			Type:        schema.TypeString,
-			Optional:    true,
-			Description: "The codec used for compression of your logs. One of: `gzip`, `snappy`, `lz4`",
+			Type:             schema.TypeString,
+			Optional:         true,
+			Description:      "The codec used for compression of your logs. One of: `gzip`, `snappy`, `lz4`",
+			ValidateDiagFunc: validateKafkaCompressionCodec(),
 		},
 
 		"required_acks": {
-			Type:     schema.TypeString,
-			Optional: true,
-			Description: "The Number of acknowledgements a leader must receive before a write is considered successful. One of: `1` (default) One server needs to respond. `0` No servers need to respond. `-1`	Wait for all in-sync replicas to respond",
+			Type:             schema.TypeString,
+			Optional:         true,
+			Description:      "The Number of acknowledgements a leader must receive before a write is considered successful. One of: `1` (default) One server needs to respond. `0` No servers need to respond. `-1`	Wait for all in-sync replicas to respond",
+			ValidateDiagFunc: validateKafkaRequiredAcks(),
 		},
 
 		"use_tls": {
 			Type:        schema.TypeBool,
 			Optional:    true,
 			Default:     false,
-			Description: "Whether to use TLS for secure logging. Can be either `true` or `false`",
+			Description: "Whether to use TLS for secure logging. Can be either `true` or `false`. The `tls_*` attributes are only used when this is `true`",
 		},
 
 		"tls_ca_cert": {
@@ -75,14 +78,14 @@ func (h *KafkaServiceAttributeHandler) GetSchema() *schema.Schema {
 		"tls_client_cert": {
 			Type:             schema.TypeString,
 			Optional:         true,
-			Description:      "The client certificate used to make authenticated requests. Must be in PEM format",
+			Description:      "The client certificate used to make authenticated requests. Must be in PEM format. Requires `tls_client_key`",
 			ValidateDiagFunc: validateStringTrimmed,
 		},
 
 		"tls_client_key": {
 			Type:             schema.TypeString,
 			Optional:         true,
-			Description:      "The client private key used to make authenticated requests. Must be in PEM format",
+			Description:      "The client private key used to make authenticated requests. Must be in PEM format. Requires `tls_client_cert`",
 			Sensitive:        true,
 			ValidateDiagFunc: validateStringTrimmed,
 		},
@@ -107,30 +110,38 @@ func (h *KafkaServiceAttributeHandler) GetSchema() *schema.Schema {
 		},
 
 		"auth_method": {
-			Type:        schema.TypeString,
-			Optional:    true,
-			Description: "SASL authentication method. One of: plain, scram-sha-256, scram-sha-512",
+			Type:             schema.TypeString,
+			Optional:         true,
+			Description:      "SASL authentication method. One of: plain, scram-sha-256, scram-sha-512. Requires `user` and `password`",
+			ValidateDiagFunc: validateKafkaAuthMethod(),
 		},
 
 		"user": {
 			Type:        schema.TypeString,
 			Optional:    true,
-			Description: "SASL User",
+			Description: "SASL User. Required when `auth_method` is set",
 		},
 
 		"password": {
 			Type:        schema.TypeString,
 			Optional:    true,
-			Description: "SASL Pass",
+			Description: "SASL Pass. Required when `auth_method` is set",
 			Sensitive:   true,
 		},
 	}
 
 	if h.GetServiceMetadata().serviceType == ServiceTypeVCL {
 		blockAttributes["format"] = &schema.Schema{
-			Type:        schema.TypeString,
+			Type:             schema.TypeString,
+			Optional:         true,
+			Description:      "Apache style log formatting.",
+			ValidateDiagFunc: validateLoggingFormat(),
+		}
+		blockAttributes["append_newline"] = &schema.Schema{
+			Type:        schema.TypeBool,
 			Optional:    true,
-			Description: "Apache style log formatting.",
+			Default:     true,
+			Description: loggingAppendNewlineDescription,
 		}
 		blockAttributes["format_version"] = &schema.Schema{
 			Type:             schema.TypeInt,
@@ -189,6 +200,8 @@ func (h *KafkaServiceAttributeHandler) Read(_ context.Context, d *schema.Resourc
 
 	for _, element := range kafkaLogList {
 		element = h.pruneVCLLoggingAttributes(element)
+		h.restoreLoggingFormat(d, element)
+		h.restoreLoggingPlacement(d, element)
 	}
 
 	if err := d.Set(h.GetKey(), kafkaLogList); err != nil {
@@ -226,8 +239,8 @@ func (h *KafkaServiceAttributeHandler) Update(_ context.Context, d *schema.Resou
 	if v, ok := modified["compression_codec"]; ok {
 		opts.CompressionCodec = gofastly.String(v.(string))
 	}
-	if v, ok := modified["format"]; ok {
-		opts.Format = gofastly.String(v.(string))
+	if v := h.modifiedLoggingFormat(resource, modified); v != nil {
+		opts.Format = v
 	}
 	if v, ok := modified["format_version"]; ok {
 		opts.FormatVersion = gofastly.Uint(uint(v.(int)))
@@ -286,6 +299,25 @@ func (h *KafkaServiceAttributeHandler) Delete(_ context.Context, d *schema.Resou
 	return nil
 }
 
+// checkKafkaAuth returns an error if SASL authentication is enabled without both a user and a password.
+func checkKafkaAuth(resource map[string]interface{}) error {
+	if resource["auth_method"].(string) == "" {
+		return nil
+	}
+	if resource["user"].(string) == "" || resource["password"].(string) == "" {
+		return fmt.Errorf("[ERR] Both user and password must be set when auth_method is set for Kafka logging endpoint (%s)", resource["name"].(string))
+	}
+	return nil
+}
+
+// checkKafkaTLSClient returns an error if only one of the client certificate and key needed for mutual TLS is set.
+func checkKafkaTLSClient(resource map[string]interface{}) error {
+	if (resource["tls_client_cert"].(string) == "") != (resource["tls_client_key"].(string) == "") {
+		return fmt.Errorf("[ERR] Both tls_client_cert and tls_client_key must be set to use a client certificate for Kafka logging endpoint (%s)", resource["name"].(string))
+	}
+	return nil
+}
+
 func createKafka(conn *gofastly.Client, i *gofastly.CreateKafkaInput) error {
 	_, err := conn.CreateKafka(i)
 	return err
@@ -76,6 +76,70 @@ func TestResourceFastlyFlattenKafka(t *testing.T) {
 	}
 }
 
+func TestResourceFastlyCheckKafkaAuth(t *testing.T) {
+	for name, c := range map[string]struct {
+		resource    map[string]interface{}
+		expectError bool
+	}{
+		"no auth_method": {
+			resource: map[string]interface{}{"name": "kafkalogger", "auth_method": "", "user": "", "password": ""},
+		},
+		"auth_method with user and password": {
+			resource: map[string]interface{}{"name": "kafkalogger", "auth_method": "plain", "user": "user", "password": "password"},
+		},
+		"auth_method without password": {
+			resource:    map[string]interface{}{"name": "kafkalogger", "auth_method": "scram-sha-256", "user": "user", "password": ""},
+			expectError: true,
+		},
+		"auth_method without user": {
+			resource:    map[string]interface{}{"name": "kafkalogger", "auth_method": "scram-sha-512", "user": "", "password": "password"},
+			expectError: true,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			err := checkKafkaAuth(c.resource)
+			if c.expectError && err == nil {
+				t.Fatal("expected an error, got none")
+			}
+			if !c.expectError && err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+		})
+	}
+}
+
+func TestResourceFastlyCheckKafkaTLSClient(t *testing.T) {
+	for name, c := range map[string]struct {
+		resource    map[string]interface{}
+		expectError bool
+	}{
+		"no client certificate": {
+			resource: map[string]interface{}{"name": "kafkalogger", "tls_client_cert": "", "tls_client_key": ""},
+		},
+		"client certificate and key": {
+			resource: map[string]interface{}{"name": "kafkalogger", "tls_client_cert": "cert", "tls_client_key": "key"},
+		},
+		"client certificate without key": {
+			resource:    map[string]interface{}{"name": "kafkalogger", "tls_client_cert": "cert", "tls_client_key": ""},
+			expectError: true,
+		},
+		"client key without certificate": {
+			resource:    map[string]interface{}{"name": "kafkalogger", "tls_client_cert": "", "tls_client_key": "key"},
+			expectError: true,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			err := checkKafkaTLSClient(c.resource)
+			if c.expectError && err == nil {
+				t.Fatal("expected an error, got none")
+			}
+			if !c.expectError && err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+		})
+	}
+}
+
 func TestAccFastlyServiceVCL_kafkalogging_basic(t *testing.T) {
 	var service gofastly.ServiceDetail
 	name := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
@@ -85,7 +149,7 @@ func TestAccFastlyServiceVCL_kafkalogging_basic(t *testing.T) {
 		ServiceVersion:    1,
 		Name:              "kafkalogger",
 		Topic:             "topic",
-		Brokers:           "127.0.0.1,127.0.0.2",
+		Brokers:           "127.0.0.1:9092,127.0.0.2:9092",
 		CompressionCodec:  "snappy",
 		RequiredACKs:      "-1",
 		UseTLS:            true,
@@ -94,7 +158,7 @@ func TestAccFastlyServiceVCL_kafkalogging_basic(t *testing.T) {
 		TLSClientKey:      privateKey(t),
 		TLSHostname:       "example.com",
 		ResponseCondition: "response_condition_test",
-		Format:            `%a %l %u %t %m %U%q %H %>s %b %T`,
+		Format:            appendNewLine(`%a %l %u %t %m %U%q %H %>s %b %T`),
 		FormatVersion:     2,
 		Placement:         "none",
 		ParseLogKeyvals:   true,
@@ -108,7 +172,7 @@ func TestAccFastlyServiceVCL_kafkalogging_basic(t *testing.T) {
 		ServiceVersion:    1,
 		Name:              "kafkalogger",
 		Topic:             "newtopic",
-		Brokers:           "127.0.0.3,127.0.0.4",
+		Brokers:           "127.0.0.3:9092,127.0.0.4:9092",
 		CompressionCodec:  "lz4",
 		RequiredACKs:      "0",
 		UseTLS:            false,
@@ -117,7 +181,7 @@ func TestAccFastlyServiceVCL_kafkalogging_basic(t *testing.T) {
 		TLSClientKey:      privateKey(t),
 		TLSHostname:       "example2.com",
 		ResponseCondition: "response_condition_test",
-		Format:            `%a %l %u %t %m %U%q %H %>s %b %T`,
+		Format:            appendNewLine(`%a %l %u %t %m %U%q %H %>s %b %T`),
 		FormatVersion:     2,
 		Placement:         "waf_debug",
 		ParseLogKeyvals:   true,
@@ -131,7 +195,7 @@ func TestAccFastlyServiceVCL_kafkalogging_basic(t *testing.T) {
 		ServiceVersion:    1,
 		Name:              "kafkalogger2",
 		Topic:             "topicb",
-		Brokers:           "127.0.0.3,127.0.0.4",
+		Brokers:           "127.0.0.3:9092,127.0.0.4:9092",
 		CompressionCodec:  "gzip",
 		RequiredACKs:      "1",
 		UseTLS:            true,
@@ -140,7 +204,7 @@ func TestAccFastlyServiceVCL_kafkalogging_basic(t *testing.T) {
 		TLSClientKey:      privateKey(t),
 		TLSHostname:       "example.com",
 		ResponseCondition: "response_condition_test",
-		Format:            `%a %l %u %t %m %U%q %H %>s %b %T`,
+		Format:            appendNewLine(`%a %l %u %t %m %U%q %H %>s %b %T`),
 		FormatVersion:     2,
 		Placement:         "none",
 		ParseLogKeyvals:   true,
@@ -192,7 +256,7 @@ func TestAccFastlyServiceVCL_kafkalogging_basic_compute(t *testing.T) {
 		ServiceVersion:   1,
 		Name:             "kafkalogger",
 		Topic:            "topic",
-		Brokers:          "127.0.0.1,127.0.0.2",
+		Brokers:          "127.0.0.1:9092,127.0.0.2:9092",
 		CompressionCodec: "snappy",
 		RequiredACKs:     "-1",
 		UseTLS:           true,
@@ -295,7 +359,7 @@ resource "fastly_service_compute" "foo" {
 	logging_kafka {
 		name               = "kafkalogger"
 	  topic  						 = "topic"
-		brokers            = "127.0.0.1,127.0.0.2"
+		brokers            = "127.0.0.1:9092,127.0.0.2:9092"
 		compression_codec  = "snappy"
 		required_acks      = "-1"
 		use_tls            = true
@@ -340,7 +404,7 @@ resource "fastly_service_vcl" "foo" {
 	logging_kafka {
 		name               = "kafkalogger"
 	  topic  						 = "topic"
-		brokers            = "127.0.0.1,127.0.0.2"
+		brokers            = "127.0.0.1:9092,127.0.0.2:9092"
 		compression_codec  = "snappy"
 		required_acks      = "-1"
 		use_tls            = true
@@ -389,7 +453,7 @@ resource "fastly_service_vcl" "foo" {
 	logging_kafka {
 		name               = "kafkalogger"
 	  topic  						 = "newtopic"
-		brokers            = "127.0.0.3,127.0.0.4"
+		brokers            = "127.0.0.3:9092,127.0.0.4:9092"
 		compression_codec  = "lz4"
 		required_acks      = "0"
 		use_tls            = false
@@ -411,7 +475,7 @@ resource "fastly_service_vcl" "foo" {
 	logging_kafka {
 		name               = "kafkalogger2"
 	  	topic  			   = "topicb"
-		brokers            = "127.0.0.3,127.0.0.4"
+		brokers            = "127.0.0.3:9092,127.0.0.4:9092"
 		compression_codec  = "gzip"
 		required_acks      = "1"
 		use_tls            = true
@@ -50,29 +50,36 @@ func (h *KinesisServiceAttributeHandler) GetSchema() *schema.Schema {
 			Type:        schema.TypeString,
 			Optional:    true,
 			Sensitive:   true,
-			Description: "The AWS access key to be used to write to the stream",
+			Description: "The AWS access key to be used to write to the stream. Requires `secret_key` and cannot be used with `iam_role`",
 		},
 
 		"secret_key": {
 			Type:        schema.TypeString,
 			Optional:    true,
 			Sensitive:   true,
-			Description: "The AWS secret access key to authenticate with",
+			Description: "The AWS secret access key to authenticate with. Requires `access_key` and cannot be used with `iam_role`",
 		},
 
 		"iam_role": {
 			Type:        schema.TypeString,
 			Optional:    true,
-			Description: "The Amazon Resource Name (ARN) for the IAM role granting Fastly access to Kinesis. Not required if `access_key` and `secret_key` are provided.",
+			Description: "The Amazon Resource Name (ARN) for the IAM role granting Fastly access to Kinesis. Not required if `access_key` and `secret_key` are provided, and cannot be used with them.",
 			Sensitive:   false,
 		},
 	}
 
 	if h.GetServiceMetadata().serviceType == ServiceTypeVCL {
 		blockAttributes["format"] = &schema.Schema{
-			Type:        schema.TypeString,
+			Type:             schema.TypeString,
+			Optional:         true,
+			Description:      "Apache style log formatting.",
+			ValidateDiagFunc: validateLoggingFormat(),
+		}
+		blockAttributes["append_newline"] = &schema.Schema{
+			Type:        schema.TypeBool,
 			Optional:    true,
-			Description: "Apache style log formatting.",
+			Default:     true,
+			Description: loggingAppendNewlineDescription,
 		}
 		blockAttributes["format_version"] = &schema.Schema{
 			Type:             schema.TypeInt,
@@ -131,6 +138,8 @@ func (h *KinesisServiceAttributeHandler) Read(_ context.Context, d *schema.Resou
 
 	for _, element := range ell {
 		element = h.pruneVCLLoggingAttributes(element)
+		h.restoreLoggingFormat(d, element)
+		h.restoreLoggingPlacement(d, element)
 	}
 
 	if err := d.Set(h.GetKey(), ell); err != nil {
@@ -168,8 +177,8 @@ func (h *KinesisServiceAttributeHandler) Update(_ context.Context, d *schema.Res
 	if v, ok := modified["iam_role"]; ok {
 		opts.IAMRole = gofastly.String(v.(string))
 	}
-	if v, ok := modified["format"]; ok {
-		opts.Format = gofastly.String(v.(string))
+	if v := h.modifiedLoggingFormat(resource, modified); v != nil {
+		opts.Format = v
 	}
 	if v, ok := modified["format_version"]; ok {
 		opts.FormatVersion = gofastly.Uint(uint(v.(int)))
@@ -201,6 +210,23 @@ func (h *KinesisServiceAttributeHandler) Delete(_ context.Context, d *schema.Res
 	return nil
 }
 
+// checkKinesisAuth returns an error unless exactly one authentication mode is configured: either an IAM role, or both
+// an access key and a secret key.
+func checkKinesisAuth(resource map[string]interface{}) error {
+	name := resource["name"].(string)
+	hasKeys := resource["access_key"].(string) != "" || resource["secret_key"].(string) != ""
+	if resource["iam_role"].(string) != "" {
+		if hasKeys {
+			return fmt.Errorf("[ERR] iam_role cannot be used with access_key and secret_key for Kinesis logging endpoint (%s)", name)
+		}
+		return nil
+	}
+	if resource["access_key"].(string) == "" || resource["secret_key"].(string) == "" {
+		return fmt.Errorf("[ERR] Either iam_role or both access_key and secret_key must be set for Kinesis logging endpoint (%s)", name)
+	}
+	return nil
+}
+
 func createKinesis(conn *gofastly.Client, i *gofastly.CreateKinesisInput) error {
 	_, err := conn.CreateKinesis(i)
 	return err
@@ -85,6 +85,46 @@ func TestResourceFastlyFlattenKinesis(t *testing.T) {
 	}
 }
 
+func TestResourceFastlyCheckKinesisAuth(t *testing.T) {
+	for name, c := range map[string]struct {
+		resource    map[string]interface{}
+		expectError bool
+	}{
+		"access_key and secret_key": {
+			resource: map[string]interface{}{"name": "kinesis-endpoint", "access_key": "access", "secret_key": "secret", "iam_role": ""},
+		},
+		"iam_role": {
+			resource: map[string]interface{}{"name": "kinesis-endpoint", "access_key": "", "secret_key": "", "iam_role": testKinesisIAMRole},
+		},
+		"iam_role with access_key and secret_key": {
+			resource:    map[string]interface{}{"name": "kinesis-endpoint", "access_key": "access", "secret_key": "secret", "iam_role": testKinesisIAMRole},
+			expectError: true,
+		},
+		"iam_role with secret_key": {
+			resource:    map[string]interface{}{"name": "kinesis-endpoint", "access_key": "", "secret_key": "secret", "iam_role": testKinesisIAMRole},
+			expectError: true,
+		},
+		"access_key without secret_key": {
+			resource:    map[string]interface{}{"name": "kinesis-endpoint", "access_key": "access", "secret_key": "", "iam_role": ""},
+			expectError: true,
+		},
+		"no credentials": {
+			resource:    map[string]interface{}{"name": "kinesis-endpoint", "access_key": "", "secret_key": "", "iam_role": ""},
+			expectError: true,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			err := checkKinesisAuth(c.resource)
+			if c.expectError && err == nil {
+				t.Fatal("expected an error, got none")
+			}
+			if !c.expectError && err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+		})
+	}
+}
+
 func TestAccFastlyServiceVCL_logging_kinesis_basic(t *testing.T) {
 	var service gofastly.ServiceDetail
 	name := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
@@ -98,7 +138,7 @@ func TestAccFastlyServiceVCL_logging_kinesis_basic(t *testing.T) {
 		AccessKey:      "whywouldyoucheckthis",
 		SecretKey:      "thisisthesecretthatneedstobe40characters",
 		FormatVersion:  2,
-		Format:         "%h %l %u %t \"%r\" %>s %b",
+		Format:         appendNewLine("%h %l %u %t \"%r\" %>s %b"),
 	}
 
 	log1_after_update := gofastly.Kinesis{
@@ -108,7 +148,7 @@ func TestAccFastlyServiceVCL_logging_kinesis_basic(t *testing.T) {
 		Region:         "us-east-1",
 		IAMRole:        testKinesisIAMRole,
 		FormatVersion:  2,
-		Format:         "%h %l %u %t \"%r\" %>s %b %T",
+		Format:         appendNewLine("%h %l %u %t \"%r\" %>s %b %T"),
 	}
 
 	log2 := gofastly.Kinesis{
@@ -118,7 +158,7 @@ func TestAccFastlyServiceVCL_logging_kinesis_basic(t *testing.T) {
 		Region:         "us-east-1",
 		IAMRole:        testKinesisIAMRole,
 		FormatVersion:  2,
-		Format:         "%h %l %u %t \"%r\" %>s %b",
+		Format:         appendNewLine("%h %l %u %t \"%r\" %>s %b"),
 	}
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -35,6 +35,7 @@ func (h *LogentriesServiceAttributeHandler) GetSchema() *schema.Schema {
 		"token": {
 			Type:        schema.TypeString,
 			Required:    true,
+			Sensitive:   true,
 			Description: "Use token based authentication (https://logentries.com/doc/input-token/)",
 		},
 		// Optional
@@ -50,14 +51,28 @@ func (h *LogentriesServiceAttributeHandler) GetSchema() *schema.Schema {
 			Default:     true,
 			Description: "Whether to use TLS for secure logging",
 		},
+		"region": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			Default:          "US",
+			Description:      "The region that log data will be sent to. One of `US`, `US-2`, `US-3`, `EU`, `CA`, `AU` or `AP`. Default: `US`",
+			ValidateDiagFunc: validateLoggingLogentriesRegion(),
+		},
 	}
 
 	if h.GetServiceMetadata().serviceType == ServiceTypeVCL {
 		blockAttributes["format"] = &schema.Schema{
-			Type:        schema.TypeString,
+			Type:             schema.TypeString,
+			Optional:         true,
+			Default:          `%h %l %u %t "%r" %>s %b`,
+			Description:      "Apache-style string or VCL variables to use for log formatting",
+			ValidateDiagFunc: validateLoggingFormat(),
+		}
+		blockAttributes["append_newline"] = &schema.Schema{
+			Type:        schema.TypeBool,
 			Optional:    true,
-			Default:     `%h %l %u %t "%r" %>s %b`,
-			Description: "Apache-style string or VCL variables to use for log formatting",
+			Default:     true,
+			Description: loggingAppendNewlineDescription,
 		}
 		blockAttributes["format_version"] = &schema.Schema{
 			Type:             schema.TypeInt,
@@ -99,6 +114,7 @@ func (h *LogentriesServiceAttributeHandler) Create(_ context.Context, d *schema.
 		Port:              uint(resource["port"].(int)),
 		UseTLS:            gofastly.Compatibool(resource["use_tls"].(bool)),
 		Token:             resource["token"].(string),
+		Region:            resource["region"].(string),
 		Format:            vla.format,
 		FormatVersion:     uintOrDefault(vla.formatVersion),
 		Placement:         vla.placement,
@@ -128,6 +144,8 @@ func (h *LogentriesServiceAttributeHandler) Read(_ context.Context, d *schema.Re
 
 	for _, element := range lel {
 		element = h.pruneVCLLoggingAttributes(element)
+		h.restoreLoggingFormat(d, element)
+		h.restoreLoggingPlacement(d, element)
 	}
 
 	if err := d.Set(h.GetKey(), lel); err != nil {
@@ -159,8 +177,8 @@ func (h *LogentriesServiceAttributeHandler) Update(_ context.Context, d *schema.
 	if v, ok := modified["token"]; ok {
 		opts.Token = gofastly.String(v.(string))
 	}
-	if v, ok := modified["format"]; ok {
-		opts.Format = gofastly.String(v.(string))
+	if v := h.modifiedLoggingFormat(resource, modified); v != nil {
+		opts.Format = v
 	}
 	if v, ok := modified["format_version"]; ok {
 		opts.FormatVersion = gofastly.Uint(uint(v.(int)))
@@ -212,6 +230,7 @@ func flattenLogentries(logentriesList []*gofastly.Logentries) []map[string]inter
 			"port":               currentLE.Port,
 			"use_tls":            currentLE.UseTLS,
 			"token":              currentLE.Token,
+			"region":             currentLE.Region,
 			"format":             currentLE.Format,
 			"format_version":     currentLE.FormatVersion,
 			"response_condition": currentLE.ResponseCondition,
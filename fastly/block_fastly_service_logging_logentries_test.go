@@ -25,6 +25,7 @@ func TestResourceFastlyFlattenLogentries(t *testing.T) {
 					Name:              "somelogentriesname",
 					Port:              8080,
 					Token:             "mytoken",
+					Region:            "EU",
 					Format:            "%h %l %u %t %r %>s",
 					FormatVersion:     1,
 					ResponseCondition: "response_condition_test",
@@ -35,6 +36,7 @@ func TestResourceFastlyFlattenLogentries(t *testing.T) {
 					"name":               "somelogentriesname",
 					"port":               uint(8080),
 					"token":              "mytoken",
+					"region":             "EU",
 					"format":             "%h %l %u %t %r %>s",
 					"format_version":     uint(1),
 					"response_condition": "response_condition_test",
@@ -64,7 +66,8 @@ func TestAccFastlyServiceVCL_logentries_basic(t *testing.T) {
 		Port:              uint(20000),
 		UseTLS:            true,
 		Token:             "token",
-		Format:            `%h %l %u %t "%r" %>s %b`,
+		Region:            "US",
+		Format:            appendNewLine(`%h %l %u %t "%r" %>s %b`),
 		FormatVersion:     2,
 		ResponseCondition: "response_condition_test",
 	}
@@ -75,7 +78,8 @@ func TestAccFastlyServiceVCL_logentries_basic(t *testing.T) {
 		Port:              uint(10000),
 		UseTLS:            false,
 		Token:             "newtoken",
-		Format:            "%h %u %t %r %>s",
+		Region:            "EU",
+		Format:            appendNewLine("%h %u %t %r %>s"),
 		FormatVersion:     2,
 		ResponseCondition: "response_condition_test",
 	}
@@ -122,6 +126,7 @@ func TestAccFastlyServiceVCL_logentries_basic_compute(t *testing.T) {
 		Port:              uint(20000),
 		UseTLS:            true,
 		Token:             "token",
+		Region:            "US",
 		Format:            `%h %l %u %t "%r" %>s %b`,
 		FormatVersion:     2,
 		ResponseCondition: "response_condition_test",
@@ -213,7 +218,8 @@ func TestAccFastlyServiceVCL_logentries_formatVersion(t *testing.T) {
 		Port:              uint(20000),
 		UseTLS:            true,
 		Token:             "token",
-		Format:            `%h %l %u %t "%r" %>s %b`,
+		Region:            "US",
+		Format:            appendNewLine(`%h %l %u %t "%r" %>s %b`),
 		FormatVersion:     2,
 		ResponseCondition: "response_condition_test",
 	}
@@ -320,6 +326,7 @@ resource "fastly_service_vcl" "foo" {
     port               = "10000"
     use_tls            = "false"
     token              = "newtoken"
+    region             = "EU"
     format             = "%%h %%u %%t %%r %%>s"
     response_condition = "response_condition_test"
   }
@@ -34,18 +34,26 @@ func (h *LogglyServiceAttributeHandler) GetSchema() *schema.Schema {
 		},
 
 		"token": {
-			Type:        schema.TypeString,
-			Required:    true,
-			Sensitive:   true,
-			Description: "The token to use for authentication (https://www.loggly.com/docs/customer-token-authentication-token/).",
+			Type:             schema.TypeString,
+			Required:         true,
+			Sensitive:        true,
+			Description:      "The token to use for authentication (https://www.loggly.com/docs/customer-token-authentication-token/).",
+			ValidateDiagFunc: validateStringTrimmed,
 		},
 	}
 
 	if h.GetServiceMetadata().serviceType == ServiceTypeVCL {
 		blockAttributes["format"] = &schema.Schema{
-			Type:        schema.TypeString,
+			Type:             schema.TypeString,
+			Optional:         true,
+			Description:      "Apache-style string or VCL variables to use for log formatting.",
+			ValidateDiagFunc: validateLoggingFormat(),
+		}
+		blockAttributes["append_newline"] = &schema.Schema{
+			Type:        schema.TypeBool,
 			Optional:    true,
-			Description: "Apache-style string or VCL variables to use for log formatting.",
+			Default:     true,
+			Description: loggingAppendNewlineDescription,
 		}
 		blockAttributes["format_version"] = &schema.Schema{
 			Type:             schema.TypeInt,
@@ -104,6 +112,8 @@ func (h *LogglyServiceAttributeHandler) Read(_ context.Context, d *schema.Resour
 
 	for _, element := range ell {
 		element = h.pruneVCLLoggingAttributes(element)
+		h.restoreLoggingFormat(d, element)
+		h.restoreLoggingPlacement(d, element)
 	}
 
 	if err := d.Set(h.GetKey(), ell); err != nil {
@@ -129,8 +139,8 @@ func (h *LogglyServiceAttributeHandler) Update(_ context.Context, d *schema.Reso
 	if v, ok := modified["token"]; ok {
 		opts.Token = gofastly.String(v.(string))
 	}
-	if v, ok := modified["format"]; ok {
-		opts.Format = gofastly.String(v.(string))
+	if v := h.modifiedLoggingFormat(resource, modified); v != nil {
+		opts.Format = v
 	}
 	if v, ok := modified["format_version"]; ok {
 		opts.FormatVersion = gofastly.Uint(uint(v.(int)))
@@ -7,8 +7,10 @@ import (
 
 	gofastly "github.com/fastly/go-fastly/v6/fastly"
 	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
@@ -34,6 +36,29 @@ func TestResourceFastlyFlattenLoggly(t *testing.T) {
 				},
 			},
 		},
+		{
+			remote: []*gofastly.Loggly{
+				{
+					ServiceVersion:    1,
+					Name:              "loggly-endpoint",
+					Token:             "token",
+					Format:            "%h %l %u %t \"%r\" %>s %b",
+					FormatVersion:     2,
+					Placement:         "none",
+					ResponseCondition: "response_condition_test",
+				},
+			},
+			local: []map[string]interface{}{
+				{
+					"name":               "loggly-endpoint",
+					"token":              "token",
+					"format":             "%h %l %u %t \"%r\" %>s %b",
+					"format_version":     uint(2),
+					"placement":          "none",
+					"response_condition": "response_condition_test",
+				},
+			},
+		},
 	}
 
 	for _, c := range cases {
@@ -44,6 +69,23 @@ func TestResourceFastlyFlattenLoggly(t *testing.T) {
 	}
 }
 
+func TestResourceFastlyLogglyTokenTrimmed(t *testing.T) {
+	v := NewServiceLoggingLoggly(ServiceMetadata{ServiceTypeVCL})
+	resource := &schema.Resource{
+		Schema: map[string]*schema.Schema{},
+	}
+	v.Register(resource)
+	token := resource.Schema["logging_loggly"].Elem.(*schema.Resource).Schema["token"]
+
+	if diags := token.ValidateDiagFunc("s3cr3t", cty.GetAttrPath("token")); diags.HasError() {
+		t.Fatalf("Unexpected error validating token: %#v", diags)
+	}
+	// A token read with file() keeps its trailing newline, which would otherwise be sent as part of the token.
+	if diags := token.ValidateDiagFunc("s3cr3t\n", cty.GetAttrPath("token")); !diags.HasError() {
+		t.Fatal("Expected an error validating a token with a trailing newline")
+	}
+}
+
 func TestAccFastlyServiceVCL_logging_loggly_basic(t *testing.T) {
 	var service gofastly.ServiceDetail
 	name := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
@@ -54,7 +96,7 @@ func TestAccFastlyServiceVCL_logging_loggly_basic(t *testing.T) {
 		Name:           "loggly-endpoint",
 		Token:          "s3cr3t",
 		FormatVersion:  2,
-		Format:         "%h %l %u %t \"%r\" %>s %b",
+		Format:         appendNewLine("%h %l %u %t \"%r\" %>s %b"),
 	}
 
 	log1_after_update := gofastly.Loggly{
@@ -62,7 +104,7 @@ func TestAccFastlyServiceVCL_logging_loggly_basic(t *testing.T) {
 		Name:           "loggly-endpoint",
 		Token:          "secret",
 		FormatVersion:  2,
-		Format:         "%h %l %u %t \"%r\" %>s %b %T",
+		Format:         appendNewLine("%h %l %u %t \"%r\" %>s %b %T"),
 	}
 
 	log2 := gofastly.Loggly{
@@ -70,7 +112,7 @@ func TestAccFastlyServiceVCL_logging_loggly_basic(t *testing.T) {
 		Name:           "another-loggly-endpoint",
 		Token:          "another-token",
 		FormatVersion:  2,
-		Format:         "%h %l %u %t \"%r\" %>s %b",
+		Format:         appendNewLine("%h %l %u %t \"%r\" %>s %b"),
 	}
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -63,7 +63,7 @@ func TestAccFastlyServiceVCL_logging_logshuttle_basic(t *testing.T) {
 		Token:          "s3cr3t",
 		URL:            "https://example.com",
 		FormatVersion:  2,
-		Format:         "%h %l %u %t \"%r\" %>s %b",
+		Format:         appendNewLine("%h %l %u %t \"%r\" %>s %b"),
 	}
 
 	log1_after_update := gofastly.Logshuttle{
@@ -72,7 +72,7 @@ func TestAccFastlyServiceVCL_logging_logshuttle_basic(t *testing.T) {
 		Token:          "secret",
 		URL:            "https://new.example.com",
 		FormatVersion:  2,
-		Format:         "%h %l %u %t \"%r\" %>s %b %T",
+		Format:         appendNewLine("%h %l %u %t \"%r\" %>s %b %T"),
 	}
 
 	log2 := gofastly.Logshuttle{
@@ -83,7 +83,7 @@ func TestAccFastlyServiceVCL_logging_logshuttle_basic(t *testing.T) {
 		Placement:         "none",
 		ResponseCondition: "response_condition_test",
 		FormatVersion:     2,
-		Format:            "%h %l %u %t \"%r\" %>s %b",
+		Format:            appendNewLine("%h %l %u %t \"%r\" %>s %b"),
 	}
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -73,7 +73,7 @@ func TestAccFastlyServiceVCL_logging_newrelic_basic(t *testing.T) {
 		Token:          "token",
 		Region:         "US",
 		FormatVersion:  2,
-		Format:         "%h %l %u %t \"%r\" %>s %b",
+		Format:         appendNewLine("%h %l %u %t \"%r\" %>s %b"),
 	}
 
 	log1_after_update := gofastly.NewRelic{
@@ -82,7 +82,7 @@ func TestAccFastlyServiceVCL_logging_newrelic_basic(t *testing.T) {
 		Token:          "t0k3n",
 		Region:         "EU",
 		FormatVersion:  2,
-		Format:         "%h %l %u %t \"%r\" %>s %b %T",
+		Format:         appendNewLine("%h %l %u %t \"%r\" %>s %b %T"),
 	}
 
 	log2 := gofastly.NewRelic{
@@ -0,0 +1,225 @@
+package fastly
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	gofastly "github.com/fastly/go-fastly/v6/fastly"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type NewRelicOTLPServiceAttributeHandler struct {
+	*DefaultServiceAttributeHandler
+}
+
+func NewServiceLoggingNewRelicOTLP(sa ServiceMetadata) ServiceAttributeDefinition {
+	return ToServiceAttributeDefinition(&NewRelicOTLPServiceAttributeHandler{
+		&DefaultServiceAttributeHandler{
+			key:             "logging_newrelicotlp",
+			serviceMetadata: sa,
+		},
+	})
+}
+
+func (h *NewRelicOTLPServiceAttributeHandler) Key() string { return h.key }
+
+func (h *NewRelicOTLPServiceAttributeHandler) GetSchema() *schema.Schema {
+	var blockAttributes = map[string]*schema.Schema{
+		// Required fields
+		"name": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "The unique name of the New Relic OTLP logging endpoint. It is important to note that changing this attribute will delete and recreate the resource",
+		},
+		"token": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Sensitive:   true,
+			Description: "The Insert API key from the Account page of your New Relic account",
+		},
+		// Optional
+		"url": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "The optional New Relic Trace Observer URL to stream logs to, overriding the endpoint for `region`",
+		},
+		"region": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			Default:          "US",
+			Description:      "The region that log data will be sent to. One of `US` or `EU`. Default: `US`",
+			ValidateDiagFunc: validateLoggingNewRelicOTLPRegion(),
+		},
+	}
+
+	if h.GetServiceMetadata().serviceType == ServiceTypeVCL {
+		blockAttributes["format"] = &schema.Schema{
+			Type:             schema.TypeString,
+			Optional:         true,
+			Description:      "Apache style log formatting. Your log must produce valid JSON that New Relic OTLP can ingest.",
+			ValidateDiagFunc: validateLoggingFormat(),
+		}
+		blockAttributes["append_newline"] = &schema.Schema{
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     true,
+			Description: loggingAppendNewlineDescription,
+		}
+		blockAttributes["format_version"] = &schema.Schema{
+			Type:             schema.TypeInt,
+			Optional:         true,
+			Default:          2,
+			Description:      "The version of the custom logging format used for the configured endpoint. Can be either `1` or `2`. (default: `2`).",
+			ValidateDiagFunc: validateLoggingFormatVersion(),
+		}
+		blockAttributes["placement"] = &schema.Schema{
+			Type:             schema.TypeString,
+			Optional:         true,
+			Description:      "Where in the generated VCL the logging call should be placed.",
+			ValidateDiagFunc: validateLoggingPlacement(),
+		}
+		blockAttributes["response_condition"] = &schema.Schema{
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "The name of the condition to apply.",
+		}
+	}
+
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: blockAttributes,
+		},
+	}
+}
+
+func (h *NewRelicOTLPServiceAttributeHandler) Create(_ context.Context, d *schema.ResourceData, resource map[string]interface {
+}, serviceVersion int, conn *gofastly.Client) error {
+	opts := h.buildCreate(resource)
+
+	log.Printf("[DEBUG] Fastly New Relic OTLP logging addition opts: %#v", opts)
+
+	if err := createNewRelicOTLP(conn, d.Id(), serviceVersion, opts); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (h *NewRelicOTLPServiceAttributeHandler) Read(_ context.Context, d *schema.ResourceData, _ map[string]interface{}, serviceVersion int, conn *gofastly.Client) error {
+	// Refresh NewRelicOTLP.
+	log.Printf("[DEBUG] Refreshing New Relic OTLP logging endpoints for (%s)", d.Id())
+	newrelicotlpList, err := listNewRelicOTLP(conn, d.Id(), serviceVersion)
+	if err != nil {
+		return fmt.Errorf("[ERR] Error looking up New Relic OTLP logging endpoints for (%s), version (%v): %s", d.Id(), serviceVersion, err)
+	}
+
+	dll := flattenNewRelicOTLP(newrelicotlpList)
+
+	for _, element := range dll {
+		element = h.pruneVCLLoggingAttributes(element)
+		h.restoreLoggingFormat(d, element)
+		h.restoreLoggingPlacement(d, element)
+	}
+
+	if err := d.Set(h.GetKey(), dll); err != nil {
+		log.Printf("[WARN] Error setting New Relic OTLP logging endpoints for (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func (h *NewRelicOTLPServiceAttributeHandler) Update(_ context.Context, d *schema.ResourceData, resource, modified map[string]interface {
+}, serviceVersion int, conn *gofastly.Client) error {
+	opts := updateNewRelicOTLPInput{}
+
+	// NOTE: where we transition between interface{} we lose the ability to
+	// infer the underlying type being either a uint vs an int. This
+	// materializes as a panic (yay) and so it's only at runtime we discover
+	// this and so we've updated the below code to convert the type asserted
+	// int into a uint before passing the value to gofastly.Uint().
+	if v, ok := modified["token"]; ok {
+		opts.Token = gofastly.String(v.(string))
+	}
+	if v, ok := modified["url"]; ok {
+		opts.URL = gofastly.String(v.(string))
+	}
+	if v, ok := modified["region"]; ok {
+		opts.Region = gofastly.String(v.(string))
+	}
+	if v := h.modifiedLoggingFormat(resource, modified); v != nil {
+		opts.Format = v
+	}
+	if v, ok := modified["format_version"]; ok {
+		opts.FormatVersion = gofastly.Uint(uint(v.(int)))
+	}
+	if v, ok := modified["response_condition"]; ok {
+		opts.ResponseCondition = gofastly.String(v.(string))
+	}
+	if v, ok := modified["placement"]; ok {
+		opts.Placement = gofastly.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Update New Relic OTLP Opts: %#v", opts)
+	if err := updateNewRelicOTLP(conn, d.Id(), serviceVersion, resource["name"].(string), &opts); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (h *NewRelicOTLPServiceAttributeHandler) Delete(_ context.Context, d *schema.ResourceData, resource map[string]interface {
+}, serviceVersion int, conn *gofastly.Client) error {
+	name := resource["name"].(string)
+
+	log.Printf("[DEBUG] Fastly New Relic OTLP logging endpoint removal: %s", name)
+
+	if err := deleteNewRelicOTLP(conn, d.Id(), serviceVersion, name); err != nil {
+		return err
+	}
+	return nil
+}
+
+func flattenNewRelicOTLP(newrelicotlpList []*newRelicOTLP) []map[string]interface{} {
+	var dsl []map[string]interface{}
+	for _, dl := range newrelicotlpList {
+		// Convert NewRelicOTLP logging to a map for saving to state.
+		ndl := map[string]interface{}{
+			"name":               dl.Name,
+			"token":              dl.Token,
+			"url":                dl.URL,
+			"format":             dl.Format,
+			"format_version":     dl.FormatVersion,
+			"placement":          dl.Placement,
+			"region":             dl.Region,
+			"response_condition": dl.ResponseCondition,
+		}
+
+		// Prune any empty values that come from the default string value in structs.
+		for k, v := range ndl {
+			if v == "" {
+				delete(ndl, k)
+			}
+		}
+
+		dsl = append(dsl, ndl)
+	}
+
+	return dsl
+}
+
+func (h *NewRelicOTLPServiceAttributeHandler) buildCreate(newrelicotlpMap interface{}) *createNewRelicOTLPInput {
+	df := newrelicotlpMap.(map[string]interface{})
+
+	var vla = h.getVCLLoggingAttributes(df)
+	return &createNewRelicOTLPInput{
+		Name:              df["name"].(string),
+		Token:             df["token"].(string),
+		URL:               df["url"].(string),
+		Format:            vla.format,
+		FormatVersion:     uintOrDefault(vla.formatVersion),
+		Placement:         vla.placement,
+		Region:            df["region"].(string),
+		ResponseCondition: vla.responseCondition,
+	}
+}
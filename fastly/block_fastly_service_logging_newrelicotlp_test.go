@@ -0,0 +1,191 @@
+package fastly
+
+import (
+	"fmt"
+	"log"
+	"testing"
+
+	gofastly "github.com/fastly/go-fastly/v6/fastly"
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestResourceFastlyFlattenNewRelicOTLP(t *testing.T) {
+	cases := []struct {
+		remote []*newRelicOTLP
+		local  []map[string]interface{}
+	}{
+		{
+			remote: []*newRelicOTLP{
+				{
+					Name:          "newrelicotlp-endpoint",
+					Token:         "token",
+					URL:           "https://otlp.nr-data.net",
+					Region:        "US",
+					FormatVersion: 2,
+				},
+			},
+			local: []map[string]interface{}{
+				{
+					"name":           "newrelicotlp-endpoint",
+					"token":          "token",
+					"url":            "https://otlp.nr-data.net",
+					"region":         "US",
+					"format_version": uint(2),
+				},
+			},
+		},
+	}
+
+	for _, c := range cases {
+		out := flattenNewRelicOTLP(c.remote)
+		if diff := cmp.Diff(out, c.local); diff != "" {
+			t.Fatalf("Error matching: %s", diff)
+		}
+	}
+}
+
+func TestAccFastlyServiceVCL_logging_newrelicotlp_basic(t *testing.T) {
+	var service gofastly.ServiceDetail
+	name := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	domain := fmt.Sprintf("fastly-test.%s.com", name)
+
+	log1 := newRelicOTLP{
+		Name:          "newrelicotlp-endpoint",
+		Token:         "token",
+		Region:        "US",
+		FormatVersion: 2,
+		Format:        appendNewLine("%h %l %u %t \"%r\" %>s %b"),
+	}
+
+	log1_after_update := newRelicOTLP{
+		Name:          "newrelicotlp-endpoint",
+		Token:         "t0k3n",
+		URL:           "https://otlp.eu01.nr-data.net",
+		Region:        "EU",
+		FormatVersion: 2,
+		Format:        appendNewLine("%h %l %u %t \"%r\" %>s %b %T"),
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckServiceVCLDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceVCLNewRelicOTLPConfig(name, domain),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceVCLExists("fastly_service_vcl.foo", &service),
+					testAccCheckFastlyServiceVCLNewRelicOTLPAttributes(&service, []*newRelicOTLP{&log1}),
+					resource.TestCheckResourceAttr(
+						"fastly_service_vcl.foo", "name", name),
+					resource.TestCheckResourceAttr(
+						"fastly_service_vcl.foo", "logging_newrelicotlp.#", "1"),
+				),
+			},
+
+			{
+				Config: testAccServiceVCLNewRelicOTLPConfig_update(name, domain),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceVCLExists("fastly_service_vcl.foo", &service),
+					testAccCheckFastlyServiceVCLNewRelicOTLPAttributes(&service, []*newRelicOTLP{&log1_after_update}),
+					resource.TestCheckResourceAttr(
+						"fastly_service_vcl.foo", "name", name),
+					resource.TestCheckResourceAttr(
+						"fastly_service_vcl.foo", "logging_newrelicotlp.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckFastlyServiceVCLNewRelicOTLPAttributes(service *gofastly.ServiceDetail, newrelicotlp []*newRelicOTLP) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+
+		conn := testAccProvider.Meta().(*FastlyClient).conn
+		newrelicotlpList, err := listNewRelicOTLP(conn, service.ID, service.ActiveVersion.Number)
+		if err != nil {
+			return fmt.Errorf("[ERR] Error looking up NewRelicOTLP Logging for (%s), version (%d): %s", service.Name, service.ActiveVersion.Number, err)
+		}
+
+		if len(newrelicotlpList) != len(newrelicotlp) {
+			return fmt.Errorf("NewRelicOTLP List count mismatch, expected (%d), got (%d)", len(newrelicotlp), len(newrelicotlpList))
+		}
+
+		log.Printf("[DEBUG] newrelicotlpList = %#v\n", newrelicotlpList)
+
+		var found int
+		for _, d := range newrelicotlp {
+			for _, dl := range newrelicotlpList {
+				if d.Name == dl.Name {
+					if diff := cmp.Diff(d, dl); diff != "" {
+						return fmt.Errorf("Bad match NewRelicOTLP logging match: %s", diff)
+					}
+					found++
+				}
+			}
+		}
+
+		if found != len(newrelicotlp) {
+			return fmt.Errorf("Error matching NewRelicOTLP Logging rules")
+		}
+
+		return nil
+	}
+}
+
+func testAccServiceVCLNewRelicOTLPConfig(name string, domain string) string {
+	return fmt.Sprintf(`
+resource "fastly_service_vcl" "foo" {
+  name = "%s"
+
+  domain {
+    name    = "%s"
+    comment = "tf-newrelicotlp-logging"
+  }
+
+  backend {
+    address = "aws.amazon.com"
+    name    = "amazon docs"
+  }
+
+  logging_newrelicotlp {
+    name   = "newrelicotlp-endpoint"
+    token  = "token"
+    format = "%%h %%l %%u %%t \"%%r\" %%>s %%b"
+  }
+
+  force_destroy = true
+}
+`, name, domain)
+}
+
+func testAccServiceVCLNewRelicOTLPConfig_update(name, domain string) string {
+	return fmt.Sprintf(`
+resource "fastly_service_vcl" "foo" {
+  name = "%s"
+
+  domain {
+    name    = "%s"
+    comment = "tf-newrelicotlp-logging"
+  }
+
+  backend {
+    address = "aws.amazon.com"
+    name    = "amazon docs"
+  }
+
+  logging_newrelicotlp {
+    name   = "newrelicotlp-endpoint"
+    token  = "t0k3n"
+    url    = "https://otlp.eu01.nr-data.net"
+    format = "%%h %%l %%u %%t \"%%r\" %%>s %%b %%T"
+    region = "EU"
+  }
+
+  force_destroy = true
+}
+`, name, domain)
+}
@@ -42,7 +42,7 @@ func (h *OpenstackServiceAttributeHandler) GetSchema() *schema.Schema {
 		"user": {
 			Type:        schema.TypeString,
 			Required:    true,
-			Description: "The username for your OpenStack account",
+			Description: "The username for your OpenStack account. Fastly authenticates to OpenStack with `user` and `access_key` only; Keystone application credentials aren't supported by the Fastly API",
 		},
 
 		"bucket_name": {
@@ -110,9 +110,16 @@ func (h *OpenstackServiceAttributeHandler) GetSchema() *schema.Schema {
 
 	if h.GetServiceMetadata().serviceType == ServiceTypeVCL {
 		blockAttributes["format"] = &schema.Schema{
-			Type:        schema.TypeString,
+			Type:             schema.TypeString,
+			Optional:         true,
+			Description:      "Apache style log formatting.",
+			ValidateDiagFunc: validateLoggingFormat(),
+		}
+		blockAttributes["append_newline"] = &schema.Schema{
+			Type:        schema.TypeBool,
 			Optional:    true,
-			Description: "Apache style log formatting.",
+			Default:     true,
+			Description: loggingAppendNewlineDescription,
 		}
 		blockAttributes["format_version"] = &schema.Schema{
 			Type:             schema.TypeInt,
@@ -145,6 +152,10 @@ func (h *OpenstackServiceAttributeHandler) GetSchema() *schema.Schema {
 
 func (h *OpenstackServiceAttributeHandler) Create(_ context.Context, d *schema.ResourceData, resource map[string]interface {
 }, serviceVersion int, conn *gofastly.Client) error {
+	if err := checkLoggingCompression(resource); err != nil {
+		return err
+	}
+
 	opts := h.buildCreate(resource, d.Id(), serviceVersion)
 
 	log.Printf("[DEBUG] Fastly OpenStack logging addition opts: %#v", opts)
@@ -171,6 +182,8 @@ func (h *OpenstackServiceAttributeHandler) Read(_ context.Context, d *schema.Res
 
 	for _, element := range ell {
 		element = h.pruneVCLLoggingAttributes(element)
+		h.restoreLoggingFormat(d, element)
+		h.restoreLoggingPlacement(d, element)
 	}
 
 	if err := d.Set(h.GetKey(), ell); err != nil {
@@ -182,6 +195,10 @@ func (h *OpenstackServiceAttributeHandler) Read(_ context.Context, d *schema.Res
 
 func (h *OpenstackServiceAttributeHandler) Update(_ context.Context, d *schema.ResourceData, resource, modified map[string]interface {
 }, serviceVersion int, conn *gofastly.Client) error {
+	if err := checkLoggingCompression(resource); err != nil {
+		return err
+	}
+
 	opts := gofastly.UpdateOpenstackInput{
 		ServiceID:      d.Id(),
 		ServiceVersion: serviceVersion,
@@ -220,8 +237,8 @@ func (h *OpenstackServiceAttributeHandler) Update(_ context.Context, d *schema.R
 	if v, ok := modified["gzip_level"]; ok {
 		opts.GzipLevel = gofastly.Uint(uint(v.(int)))
 	}
-	if v, ok := modified["format"]; ok {
-		opts.Format = gofastly.String(v.(string))
+	if v := h.modifiedLoggingFormat(resource, modified); v != nil {
+		opts.Format = v
 	}
 	if v, ok := modified["format_version"]; ok {
 		opts.FormatVersion = gofastly.Uint(uint(v.(int)))
@@ -310,6 +327,7 @@ func flattenOpenstack(openstackList []*gofastly.Openstack) []map[string]interfac
 				delete(nll, k)
 			}
 		}
+		pruneLoggingCompression(nll)
 
 		lsl = append(lsl, nll)
 	}
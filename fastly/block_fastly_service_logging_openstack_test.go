@@ -54,7 +54,6 @@ func TestResourceFastlyFlattenOpenstack(t *testing.T) {
 					"timestamp_format":   "%Y-%m-%dT%H:%M:%S.000",
 					"response_condition": "always",
 					"period":             uint(3600),
-					"gzip_level":         uint(0),
 					"compression_codec":  "zstd",
 				},
 			},
@@ -82,7 +81,7 @@ func TestAccFastlyServiceVCL_logging_openstack_basic(t *testing.T) {
 		BucketName:        "bucket",
 		AccessKey:         "s3cr3t",
 		PublicKey:         pgpPublicKey(t),
-		Format:            "%h %l %u %t \"%r\" %>s %b",
+		Format:            appendNewLine("%h %l %u %t \"%r\" %>s %b"),
 		FormatVersion:     2,
 		MessageType:       "classic",
 		Path:              "/",
@@ -96,7 +95,7 @@ func TestAccFastlyServiceVCL_logging_openstack_basic(t *testing.T) {
 	log1_after_update := gofastly.Openstack{
 		ServiceVersion:    1,
 		Name:              "openstack-endpoint",
-		Format:            "%h %l %u %t \"%r\" %>s %b %T",
+		Format:            appendNewLine("%h %l %u %t \"%r\" %>s %b %T"),
 		URL:               "https://auth.example.com/v2", // /v1, /v2 or /v3 are required to be in the path.
 		User:              "userupdate",
 		BucketName:        "bucketupdate",
@@ -120,7 +119,7 @@ func TestAccFastlyServiceVCL_logging_openstack_basic(t *testing.T) {
 		BucketName:        "bucket2",
 		AccessKey:         "s3cr3t2",
 		PublicKey:         pgpPublicKey(t),
-		Format:            "%h %l %u %t \"%r\" %>s %b",
+		Format:            appendNewLine("%h %l %u %t \"%r\" %>s %b"),
 		FormatVersion:     2,
 		MessageType:       "classic",
 		Path:              "two/",
@@ -46,10 +46,17 @@ func (h *PaperTrailServiceAttributeHandler) GetSchema() *schema.Schema {
 
 	if h.GetServiceMetadata().serviceType == ServiceTypeVCL {
 		blockAttributes["format"] = &schema.Schema{
-			Type:        schema.TypeString,
+			Type:             schema.TypeString,
+			Optional:         true,
+			Default:          `%h %l %u %t "%r" %>s %b`,
+			Description:      "A Fastly [log format string](https://docs.fastly.com/en/guides/custom-log-formats)",
+			ValidateDiagFunc: validateLoggingFormat(),
+		}
+		blockAttributes["append_newline"] = &schema.Schema{
+			Type:        schema.TypeBool,
 			Optional:    true,
-			Default:     `%h %l %u %t "%r" %>s %b`,
-			Description: "A Fastly [log format string](https://docs.fastly.com/en/guides/custom-log-formats)",
+			Default:     true,
+			Description: loggingAppendNewlineDescription,
 		}
 		blockAttributes["format_version"] = &schema.Schema{
 			Type:             schema.TypeInt,
@@ -120,6 +127,8 @@ func (h *PaperTrailServiceAttributeHandler) Read(_ context.Context, d *schema.Re
 
 	for _, element := range pl {
 		element = h.pruneVCLLoggingAttributes(element)
+		h.restoreLoggingFormat(d, element)
+		h.restoreLoggingPlacement(d, element)
 	}
 
 	if err := d.Set(h.GetKey(), pl); err != nil {
@@ -151,8 +160,8 @@ func (h *PaperTrailServiceAttributeHandler) Update(_ context.Context, d *schema.
 	if v, ok := modified["format_version"]; ok {
 		opts.FormatVersion = gofastly.Uint(uint(v.(int)))
 	}
-	if v, ok := modified["format"]; ok {
-		opts.Format = gofastly.String(v.(string))
+	if v := h.modifiedLoggingFormat(resource, modified); v != nil {
+		opts.Format = v
 	}
 	if v, ok := modified["response_condition"]; ok {
 		opts.ResponseCondition = gofastly.String(v.(string))
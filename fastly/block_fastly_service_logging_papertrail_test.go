@@ -61,7 +61,7 @@ func TestAccFastlyServiceVCL_papertrail_basic(t *testing.T) {
 		Name:              "papertrailtesting",
 		Address:           "test1.papertrailapp.com",
 		Port:              uint(3600),
-		Format:            `%h %l %u %t "%r" %>s %b`,
+		Format:            appendNewLine(`%h %l %u %t "%r" %>s %b`),
 		FormatVersion:     uint(2),
 		ResponseCondition: "test_response_condition",
 	}
@@ -71,7 +71,7 @@ func TestAccFastlyServiceVCL_papertrail_basic(t *testing.T) {
 		Name:           "papertrailtesting2",
 		Address:        "test2.papertrailapp.com",
 		Port:           uint(8080),
-		Format:         `%h %l %u %t "%r" %>s %b`,
+		Format:         appendNewLine(`%h %l %u %t "%r" %>s %b`),
 		FormatVersion:  uint(2),
 	}
 
@@ -6,10 +6,19 @@ import (
 	"log"
 
 	gofastly "github.com/fastly/go-fastly/v6/fastly"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
+const (
+	// s3GzipWarningLevel is the gzip_level from which we warn about frequent log delivery.
+	s3GzipWarningLevel = 7
+
+	// s3GzipWarningPeriod is the period, in seconds, below which we warn about expensive gzip levels.
+	s3GzipWarningPeriod = 300
+)
+
 type S3LoggingServiceAttributeHandler struct {
 	*DefaultServiceAttributeHandler
 }
@@ -88,7 +97,7 @@ func (h *S3LoggingServiceAttributeHandler) GetSchema() *schema.Schema {
 		"path": {
 			Type:        schema.TypeString,
 			Optional:    true,
-			Description: "Path to store the files. Must end with a trailing slash. If this field is left empty, the files will be saved in the bucket's root path",
+			Description: "Path to store the files. Must end with a trailing slash. If this field is left empty, the files will be saved in the bucket's root path. The path is sent to Fastly as written, and [strftime](https://man7.org/linux/man-pages/man3/strftime.3.html) tokens in it are expanded with the time the file is written, e.g. `/logs/%Y/%m/%d/` to partition by day. Request attributes can't be used in the path",
 		},
 		"domain": {
 			Type:        schema.TypeString,
@@ -106,7 +115,7 @@ func (h *S3LoggingServiceAttributeHandler) GetSchema() *schema.Schema {
 			Type:        schema.TypeInt,
 			Optional:    true,
 			Default:     3600,
-			Description: "How frequently the logs should be transferred, in seconds. Default `3600`",
+			Description: "How frequently the logs should be transferred, in seconds. A period under 300 seconds combined with a `gzip_level` of 7 or more is reported as a warning on apply. Default `3600`",
 		},
 		"timestamp_format": {
 			Type:        schema.TypeString,
@@ -162,10 +171,17 @@ func (h *S3LoggingServiceAttributeHandler) GetSchema() *schema.Schema {
 
 	if h.GetServiceMetadata().serviceType == ServiceTypeVCL {
 		blockAttributes["format"] = &schema.Schema{
-			Type:        schema.TypeString,
+			Type:             schema.TypeString,
+			Optional:         true,
+			Default:          `%h %l %u %t "%r" %>s %b`,
+			Description:      "Apache-style string or VCL variables to use for log formatting.",
+			ValidateDiagFunc: validateLoggingFormat(),
+		}
+		blockAttributes["append_newline"] = &schema.Schema{
+			Type:        schema.TypeBool,
 			Optional:    true,
-			Default:     `%h %l %u %t "%r" %>s %b`,
-			Description: "Apache-style string or VCL variables to use for log formatting.",
+			Default:     true,
+			Description: loggingAppendNewlineDescription,
 		}
 		blockAttributes["format_version"] = &schema.Schema{
 			Type:             schema.TypeInt,
@@ -199,6 +215,10 @@ func (h *S3LoggingServiceAttributeHandler) GetSchema() *schema.Schema {
 
 func (h *S3LoggingServiceAttributeHandler) Create(_ context.Context, d *schema.ResourceData, resource map[string]interface {
 }, serviceVersion int, conn *gofastly.Client) error {
+	if err := checkLoggingCompression(resource); err != nil {
+		return err
+	}
+
 	opts, err := h.buildCreate(resource, d.Id(), serviceVersion)
 	if err != nil {
 		return err
@@ -227,6 +247,8 @@ func (h *S3LoggingServiceAttributeHandler) Read(_ context.Context, d *schema.Res
 
 	for _, element := range sl {
 		element = h.pruneVCLLoggingAttributes(element)
+		h.restoreLoggingFormat(d, element)
+		h.restoreLoggingPlacement(d, element)
 	}
 
 	if err := d.Set(h.GetKey(), sl); err != nil {
@@ -237,6 +259,10 @@ func (h *S3LoggingServiceAttributeHandler) Read(_ context.Context, d *schema.Res
 
 func (h *S3LoggingServiceAttributeHandler) Update(_ context.Context, d *schema.ResourceData, resource, modified map[string]interface {
 }, serviceVersion int, conn *gofastly.Client) error {
+	if err := checkLoggingCompression(resource); err != nil {
+		return err
+	}
+
 	opts := gofastly.UpdateS3Input{
 		ServiceID:      d.Id(),
 		ServiceVersion: serviceVersion,
@@ -275,8 +301,8 @@ func (h *S3LoggingServiceAttributeHandler) Update(_ context.Context, d *schema.R
 	if v, ok := modified["gzip_level"]; ok {
 		opts.GzipLevel = gofastly.Uint(uint(v.(int)))
 	}
-	if v, ok := modified["format"]; ok {
-		opts.Format = gofastly.String(v.(string))
+	if v := h.modifiedLoggingFormat(resource, modified); v != nil {
+		opts.Format = v
 	}
 	if v, ok := modified["format_version"]; ok {
 		opts.FormatVersion = gofastly.Uint(uint(v.(int)))
@@ -385,6 +411,7 @@ func flattenS3s(s3List []*gofastly.S3) []map[string]interface{} {
 				delete(ns, k)
 			}
 		}
+		pruneLoggingCompression(ns)
 
 		sl = append(sl, ns)
 	}
@@ -441,3 +468,29 @@ func (h *S3LoggingServiceAttributeHandler) buildDelete(s3Map interface{}, servic
 		Name:           df["name"].(string),
 	}
 }
+
+// s3GzipPeriodWarnings returns a warning for every S3 logging endpoint combining a high gzip_level with a short period.
+// Compressing small batches at high levels is CPU intensive and can delay log delivery, so this is informational only.
+func s3GzipPeriodWarnings(d *schema.ResourceData) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	s3s, ok := d.Get("logging_s3").(*schema.Set)
+	if !ok {
+		return diags
+	}
+
+	for _, elem := range s3s.List() {
+		m := elem.(map[string]interface{})
+		gzipLevel, _ := m["gzip_level"].(int)
+		period, _ := m["period"].(int)
+		if gzipLevel >= s3GzipWarningLevel && period < s3GzipWarningPeriod {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  "High gzip_level with a short S3 logging period",
+				Detail:   fmt.Sprintf("S3 logging endpoint '%s' uses gzip_level %d with a period of %d seconds, which can cause high CPU usage and delayed log delivery. Consider setting compression_codec to \"zstd\" or using a period of at least %d seconds", m["name"], gzipLevel, period, s3GzipWarningPeriod),
+			})
+		}
+	}
+
+	return diags
+}
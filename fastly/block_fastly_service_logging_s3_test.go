@@ -60,7 +60,6 @@ func TestResourceFastlyFlattenS3(t *testing.T) {
 					"s3_secret_key":                     testAwsPrimarySecretKey,
 					"path":                              "/",
 					"period":                            uint(3600),
-					"gzip_level":                        uint(0),
 					"format":                            "%h %l %u %t %r %>s",
 					"format_version":                    uint(2),
 					"response_condition":                "response_condition_test",
@@ -122,6 +121,29 @@ func TestResourceFastlyFlattenS3(t *testing.T) {
 				},
 			},
 		},
+		{
+			// The API fills in gzip_level for the gzip codec.
+			remote: []*gofastly.S3{
+				{
+					Name:             "s3-endpoint",
+					BucketName:       "bucket",
+					GzipLevel:        3,
+					CompressionCodec: "gzip",
+				},
+			},
+			local: []map[string]interface{}{
+				{
+					"name":                   "s3-endpoint",
+					"bucket_name":            "bucket",
+					"compression_codec":      "gzip",
+					"period":                 uint(0),
+					"format_version":         uint(0),
+					"redundancy":             gofastly.S3Redundancy(""),
+					"server_side_encryption": gofastly.S3ServerSideEncryption(""),
+					"acl":                    gofastly.S3AccessControlList(""),
+				},
+			},
+		},
 	}
 
 	for i, c := range cases {
@@ -134,6 +156,41 @@ func TestResourceFastlyFlattenS3(t *testing.T) {
 	}
 }
 
+func TestResourceFastlyS3GzipPeriodWarnings(t *testing.T) {
+	for _, c := range []struct {
+		name     string
+		s3       map[string]interface{}
+		expected int
+	}{
+		{
+			name:     "high gzip level with short period",
+			s3:       map[string]interface{}{"name": "s3", "bucket_name": "bucket", "gzip_level": 9, "period": 60},
+			expected: 1,
+		},
+		{
+			name:     "high gzip level with default period",
+			s3:       map[string]interface{}{"name": "s3", "bucket_name": "bucket", "gzip_level": 9},
+			expected: 0,
+		},
+		{
+			name:     "low gzip level with short period",
+			s3:       map[string]interface{}{"name": "s3", "bucket_name": "bucket", "gzip_level": 3, "period": 60},
+			expected: 0,
+		},
+	} {
+		t.Run(c.name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, resourceServiceVCL().Schema, map[string]interface{}{
+				"name":       "test",
+				"logging_s3": []interface{}{c.s3},
+			})
+			diags := s3GzipPeriodWarnings(d)
+			if len(diags) != c.expected {
+				t.Fatalf("expected %d warnings, got %d: %#v", c.expected, len(diags), diags)
+			}
+		})
+	}
+}
+
 func TestAccFastlyServiceVCL_s3logging_basic(t *testing.T) {
 	var service gofastly.ServiceDetail
 	name := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
@@ -148,7 +205,7 @@ func TestAccFastlyServiceVCL_s3logging_basic(t *testing.T) {
 		SecretKey:         testAwsPrimarySecretKey,
 		Period:            uint(3600),
 		PublicKey:         pgpPublicKey(t),
-		Format:            `%h %l %u %t "%r" %>s %b`,
+		Format:            appendNewLine(`%h %l %u %t "%r" %>s %b`),
 		FormatVersion:     2,
 		MessageType:       "classic",
 		TimestampFormat:   "%Y-%m-%dT%H:%M:%S.000",
@@ -165,7 +222,7 @@ func TestAccFastlyServiceVCL_s3logging_basic(t *testing.T) {
 		Period:            uint(3600),
 		PublicKey:         pgpPublicKey(t),
 		GzipLevel:         uint(3),
-		Format:            `%h %l %u %t "%r" %>s %b`,
+		Format:            appendNewLine(`%h %l %u %t "%r" %>s %b`),
 		FormatVersion:     2,
 		MessageType:       "blank",
 		Redundancy:        "reduced_redundancy",
@@ -182,7 +239,7 @@ func TestAccFastlyServiceVCL_s3logging_basic(t *testing.T) {
 		IAMRole:          testS3IAMRole,
 		GzipLevel:        uint(0),
 		Period:           uint(60),
-		Format:           `%h %l %u %t "%r" %>s %b`,
+		Format:           appendNewLine(`%h %l %u %t "%r" %>s %b`),
 		FormatVersion:    2,
 		MessageType:      "classic",
 		TimestampFormat:  "%Y-%m-%dT%H:%M:%S.000",
@@ -276,7 +333,7 @@ func TestAccFastlyServiceVCL_s3logging_domain_default(t *testing.T) {
 		SecretKey:         testAwsPrimarySecretKey,
 		Period:            uint(3600),
 		GzipLevel:         uint(0),
-		Format:            `%h %l %u %t "%r" %>s %b`,
+		Format:            appendNewLine(`%h %l %u %t "%r" %>s %b`),
 		FormatVersion:     2,
 		TimestampFormat:   "%Y-%m-%dT%H:%M:%S.000",
 		MessageType:       "classic",
@@ -317,7 +374,7 @@ func TestAccFastlyServiceVCL_s3logging_formatVersion(t *testing.T) {
 		SecretKey:       testAwsPrimarySecretKey,
 		Period:          uint(3600),
 		GzipLevel:       uint(0),
-		Format:          "%a %l %u %t %m %U%q %H %>s %b %T",
+		Format:          appendNewLine("%a %l %u %t %m %U%q %H %>s %b %T"),
 		FormatVersion:   2,
 		MessageType:     "classic",
 		TimestampFormat: "%Y-%m-%dT%H:%M:%S.000",
@@ -343,6 +400,30 @@ func TestAccFastlyServiceVCL_s3logging_formatVersion(t *testing.T) {
 	})
 }
 
+func TestResourceFastlyS3LoggingPathVerbatim(t *testing.T) {
+	path := "/logs/%Y/%m/%d/%H/%M-%S/"
+	d := schema.TestResourceDataRaw(t, resourceServiceVCL().Schema, map[string]interface{}{
+		"name": "test",
+		"logging_s3": []interface{}{
+			map[string]interface{}{"name": "s3", "bucket_name": "bucket", "path": path},
+		},
+	})
+	h := &S3LoggingServiceAttributeHandler{&DefaultServiceAttributeHandler{key: "logging_s3", serviceMetadata: ServiceMetadata{ServiceTypeVCL}}}
+
+	opts, err := h.buildCreate(d.Get("logging_s3").(*schema.Set).List()[0], "service", 1)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if opts.Path != path {
+		t.Fatalf("expected path %q to be sent as is, got %q", path, opts.Path)
+	}
+
+	out := flattenS3s([]*gofastly.S3{{Name: "s3", BucketName: "bucket", Path: path}})
+	if out[0]["path"] != path {
+		t.Fatalf("expected path %q to be read back as is, got %q", path, out[0]["path"])
+	}
+}
+
 func TestS3loggingEnvDefaultFuncAttributes(t *testing.T) {
 	serviceAttributes := ServiceMetadata{ServiceTypeVCL}
 	v := NewServiceLoggingS3(serviceAttributes)
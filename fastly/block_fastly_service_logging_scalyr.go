@@ -41,19 +41,33 @@ func (h *ScalyrServiceAttributeHandler) GetSchema() *schema.Schema {
 		},
 
 		// Optional
-		"region": {
+		"project_id": {
 			Type:        schema.TypeString,
 			Optional:    true,
-			Default:     "US",
-			Description: "The region that log data will be sent to. One of `US` or `EU`. Defaults to `US` if undefined",
+			Computed:    true,
+			Description: "The name of the logfile within Scalyr. If not set, Fastly uses `logplex`",
+		},
+		"region": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			Default:          "US",
+			Description:      "The region that log data will be sent to. One of `US` or `EU`. Defaults to `US` if undefined",
+			ValidateDiagFunc: validateLoggingScalyrRegion(),
 		},
 	}
 
 	if h.GetServiceMetadata().serviceType == ServiceTypeVCL {
 		blockAttributes["format"] = &schema.Schema{
-			Type:        schema.TypeString,
+			Type:             schema.TypeString,
+			Optional:         true,
+			Description:      "Apache style log formatting.",
+			ValidateDiagFunc: validateLoggingFormat(),
+		}
+		blockAttributes["append_newline"] = &schema.Schema{
+			Type:        schema.TypeBool,
 			Optional:    true,
-			Description: "Apache style log formatting.",
+			Default:     true,
+			Description: loggingAppendNewlineDescription,
 		}
 		blockAttributes["format_version"] = &schema.Schema{
 			Type:             schema.TypeInt,
@@ -93,6 +107,12 @@ func (h *ScalyrServiceAttributeHandler) Create(_ context.Context, d *schema.Reso
 	if err := createScalyr(conn, opts); err != nil {
 		return err
 	}
+
+	if v, ok := resource["project_id"].(string); ok && v != "" {
+		if err := updateScalyrProjectID(conn, d.Id(), serviceVersion, opts.Name, v); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -110,8 +130,20 @@ func (h *ScalyrServiceAttributeHandler) Read(_ context.Context, d *schema.Resour
 
 	scalyrLogList := flattenScalyr(scalyrList)
 
+	projectIDs, err := listScalyrProjectIDs(conn, d.Id(), serviceVersion)
+	if err != nil {
+		return fmt.Errorf("[ERR] Error looking up Scalyr logging project IDs for (%s), version (%v): %s", d.Id(), serviceVersion, err)
+	}
+	for _, element := range scalyrLogList {
+		if v := projectIDs[element["name"].(string)]; v != "" {
+			element["project_id"] = v
+		}
+	}
+
 	for _, element := range scalyrLogList {
 		element = h.pruneVCLLoggingAttributes(element)
+		h.restoreLoggingFormat(d, element)
+		h.restoreLoggingPlacement(d, element)
 	}
 
 	if err := d.Set(h.GetKey(), scalyrLogList); err != nil {
@@ -134,8 +166,8 @@ func (h *ScalyrServiceAttributeHandler) Update(_ context.Context, d *schema.Reso
 	// materializes as a panic (yay) and so it's only at runtime we discover
 	// this and so we've updated the below code to convert the type asserted
 	// int into a uint before passing the value to gofastly.Uint().
-	if v, ok := modified["format"]; ok {
-		opts.Format = gofastly.String(v.(string))
+	if v := h.modifiedLoggingFormat(resource, modified); v != nil {
+		opts.Format = v
 	}
 	if v, ok := modified["format_version"]; ok {
 		opts.FormatVersion = gofastly.Uint(uint(v.(int)))
@@ -158,6 +190,12 @@ func (h *ScalyrServiceAttributeHandler) Update(_ context.Context, d *schema.Reso
 	if err != nil {
 		return err
 	}
+
+	if v, ok := modified["project_id"]; ok {
+		if err := updateScalyrProjectID(conn, d.Id(), serviceVersion, opts.Name, v.(string)); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -63,7 +63,7 @@ func TestAccFastlyServiceVCL_scalyrlogging_basic(t *testing.T) {
 		Name:              "scalyrlogger",
 		Token:             "tkn",
 		Placement:         "none",
-		Format:            `%a %l %u %t %m %U%q %H %>s %b %T`,
+		Format:            appendNewLine(`%a %l %u %t %m %U%q %H %>s %b %T`),
 		ResponseCondition: "response_condition_test",
 
 		Region:        "US",
@@ -76,7 +76,7 @@ func TestAccFastlyServiceVCL_scalyrlogging_basic(t *testing.T) {
 		Region:            "EU",
 		Token:             "newtkn",
 		Placement:         "waf_debug",
-		Format:            `%a %l %u %t %m %U%q %H %>s %b %T`,
+		Format:            appendNewLine(`%a %l %u %t %m %U%q %H %>s %b %T`),
 		ResponseCondition: "response_condition_test",
 
 		FormatVersion: 2,
@@ -87,7 +87,7 @@ func TestAccFastlyServiceVCL_scalyrlogging_basic(t *testing.T) {
 		Name:           "another-scalyrlogger",
 		Token:          "tknb",
 		Placement:      "none",
-		Format:         `%a %l %u %t %m %U%q %H %>s %b %T`,
+		Format:         appendNewLine(`%a %l %u %t %m %U%q %H %>s %b %T`),
 
 		Region:        "US",
 		FormatVersion: 2,
@@ -104,6 +104,7 @@ func TestAccFastlyServiceVCL_scalyrlogging_basic(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckServiceVCLExists("fastly_service_vcl.foo", &service),
 					testAccCheckFastlyServiceVCLScalyrAttributes(&service, []*gofastly.Scalyr{&log1}, ServiceTypeVCL),
+					testAccCheckFastlyServiceVCLScalyrProjectIDs(&service, map[string]string{"scalyrlogger": "logplex"}),
 					resource.TestCheckResourceAttr(
 						"fastly_service_vcl.foo", "name", name),
 					resource.TestCheckResourceAttr(
@@ -116,6 +117,7 @@ func TestAccFastlyServiceVCL_scalyrlogging_basic(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckServiceVCLExists("fastly_service_vcl.foo", &service),
 					testAccCheckFastlyServiceVCLScalyrAttributes(&service, []*gofastly.Scalyr{&log1_after_update, &log2}, ServiceTypeVCL),
+					testAccCheckFastlyServiceVCLScalyrProjectIDs(&service, map[string]string{"scalyrlogger": "fastly-logs", "another-scalyrlogger": "logplex"}),
 					resource.TestCheckResourceAttr(
 						"fastly_service_vcl.foo", "name", name),
 					resource.TestCheckResourceAttr(
@@ -213,6 +215,21 @@ func testAccCheckFastlyServiceVCLScalyrAttributes(service *gofastly.ServiceDetai
 	}
 }
 
+func testAccCheckFastlyServiceVCLScalyrProjectIDs(service *gofastly.ServiceDetail, expected map[string]string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*FastlyClient).conn
+		projectIDs, err := listScalyrProjectIDs(conn, service.ID, service.ActiveVersion.Number)
+		if err != nil {
+			return fmt.Errorf("[ERR] Error looking up Scalyr Logging project IDs for (%s), version (%d): %s", service.Name, service.ActiveVersion.Number, err)
+		}
+
+		if diff := cmp.Diff(expected, projectIDs); diff != "" {
+			return fmt.Errorf("Bad match Scalyr logging project IDs: %s", diff)
+		}
+		return nil
+	}
+}
+
 func testAccServiceVCLScalyrComputeConfig(name string, domain string) string {
 	return fmt.Sprintf(`
 resource "fastly_service_compute" "foo" {
@@ -307,6 +324,7 @@ resource "fastly_service_vcl" "foo" {
 		name               = "scalyrlogger"
 		region             = "EU"
 		token              = "newtkn"
+		project_id         = "fastly-logs"
 		format             = "%%a %%l %%u %%t %%m %%U%%q %%H %%>s %%b %%T"
 		format_version 		 = 2
 		response_condition = "response_condition_test"
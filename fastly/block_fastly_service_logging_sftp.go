@@ -59,10 +59,11 @@ func (h *SFTPServiceAttributeHandler) GetSchema() *schema.Schema {
 
 		// Optional fields
 		"port": {
-			Type:        schema.TypeInt,
-			Optional:    true,
-			Default:     22,
-			Description: "The port the SFTP service listens on. (Default: `22`)",
+			Type:             schema.TypeInt,
+			Optional:         true,
+			Default:          22,
+			Description:      "The port the SFTP service listens on. (Default: `22`)",
+			ValidateDiagFunc: validatePortNumber(),
 		},
 
 		"password": {
@@ -125,10 +126,17 @@ func (h *SFTPServiceAttributeHandler) GetSchema() *schema.Schema {
 
 	if h.GetServiceMetadata().serviceType == ServiceTypeVCL {
 		blockAttributes["format"] = &schema.Schema{
-			Type:        schema.TypeString,
+			Type:             schema.TypeString,
+			Optional:         true,
+			Default:          "%h %l %u %t \"%r\" %>s %b",
+			Description:      "Apache-style string or VCL variables to use for log formatting.",
+			ValidateDiagFunc: validateLoggingFormat(),
+		}
+		blockAttributes["append_newline"] = &schema.Schema{
+			Type:        schema.TypeBool,
 			Optional:    true,
-			Default:     "%h %l %u %t \"%r\" %>s %b",
-			Description: "Apache-style string or VCL variables to use for log formatting.",
+			Default:     true,
+			Description: loggingAppendNewlineDescription,
 		}
 		blockAttributes["format_version"] = &schema.Schema{
 			Type:             schema.TypeInt,
@@ -161,12 +169,12 @@ func (h *SFTPServiceAttributeHandler) GetSchema() *schema.Schema {
 
 func (h *SFTPServiceAttributeHandler) Create(_ context.Context, d *schema.ResourceData, resource map[string]interface {
 }, serviceVersion int, conn *gofastly.Client) error {
-	opts := h.buildCreate(resource, d.Id(), serviceVersion)
-
-	if opts.Password == "" && opts.SecretKey == "" {
-		return fmt.Errorf("[ERR] Either password or secret_key must be set")
+	if err := checkLoggingCompression(resource); err != nil {
+		return err
 	}
 
+	opts := h.buildCreate(resource, d.Id(), serviceVersion)
+
 	log.Printf("[DEBUG] Fastly SFTP logging addition opts: %#v", opts)
 
 	if err := createSFTP(conn, opts); err != nil {
@@ -191,6 +199,8 @@ func (h *SFTPServiceAttributeHandler) Read(_ context.Context, d *schema.Resource
 
 	for _, element := range ell {
 		element = h.pruneVCLLoggingAttributes(element)
+		h.restoreLoggingFormat(d, element)
+		h.restoreLoggingPlacement(d, element)
 	}
 
 	if err := d.Set(h.GetKey(), ell); err != nil {
@@ -202,6 +212,10 @@ func (h *SFTPServiceAttributeHandler) Read(_ context.Context, d *schema.Resource
 
 func (h *SFTPServiceAttributeHandler) Update(_ context.Context, d *schema.ResourceData, resource, modified map[string]interface {
 }, serviceVersion int, conn *gofastly.Client) error {
+	if err := checkLoggingCompression(resource); err != nil {
+		return err
+	}
+
 	opts := gofastly.UpdateSFTPInput{
 		ServiceID:      d.Id(),
 		ServiceVersion: serviceVersion,
@@ -249,8 +263,8 @@ func (h *SFTPServiceAttributeHandler) Update(_ context.Context, d *schema.Resour
 	if v, ok := modified["gzip_level"]; ok {
 		opts.GzipLevel = gofastly.Uint(uint(v.(int)))
 	}
-	if v, ok := modified["format"]; ok {
-		opts.Format = gofastly.String(v.(string))
+	if v := h.modifiedLoggingFormat(resource, modified); v != nil {
+		opts.Format = v
 	}
 	if v, ok := modified["response_condition"]; ok {
 		opts.ResponseCondition = gofastly.String(v.(string))
@@ -285,6 +299,14 @@ func (h *SFTPServiceAttributeHandler) Delete(_ context.Context, d *schema.Resour
 	return nil
 }
 
+// checkSFTPAuth returns an error if neither a password nor a secret key is set to authenticate with the server.
+func checkSFTPAuth(resource map[string]interface{}) error {
+	if resource["password"].(string) == "" && resource["secret_key"].(string) == "" {
+		return fmt.Errorf("[ERR] Either password or secret_key must be set for SFTP logging endpoint (%s)", resource["name"].(string))
+	}
+	return nil
+}
+
 func createSFTP(conn *gofastly.Client, i *gofastly.CreateSFTPInput) error {
 	_, err := conn.CreateSFTP(i)
 	return err
@@ -338,6 +360,7 @@ func flattenSFTP(sftpList []*gofastly.SFTP) []map[string]interface{} {
 				delete(nsl, k)
 			}
 		}
+		pruneLoggingCompression(nsl)
 
 		ssl = append(ssl, nsl)
 	}
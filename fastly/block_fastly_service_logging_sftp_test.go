@@ -54,7 +54,6 @@ func TestResourceFastlyFlattenSFTP(t *testing.T) {
 					"format":             "%h %l %u %t \"%r\" %>s %b",
 					"password":           "password",
 					"message_type":       "classic",
-					"gzip_level":         uint8(0),
 					"format_version":     uint(2),
 					"period":             uint(3600),
 					"port":               uint(22),
@@ -75,6 +74,37 @@ func TestResourceFastlyFlattenSFTP(t *testing.T) {
 	}
 }
 
+func TestResourceFastlyCheckSFTPAuth(t *testing.T) {
+	for name, c := range map[string]struct {
+		resource    map[string]interface{}
+		expectError bool
+	}{
+		"password": {
+			resource: map[string]interface{}{"name": "sftp-endpoint", "password": "password", "secret_key": ""},
+		},
+		"secret_key": {
+			resource: map[string]interface{}{"name": "sftp-endpoint", "password": "", "secret_key": "secret"},
+		},
+		"password and secret_key": {
+			resource: map[string]interface{}{"name": "sftp-endpoint", "password": "password", "secret_key": "secret"},
+		},
+		"neither password nor secret_key": {
+			resource:    map[string]interface{}{"name": "sftp-endpoint", "password": "", "secret_key": ""},
+			expectError: true,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			err := checkSFTPAuth(c.resource)
+			if c.expectError && err == nil {
+				t.Fatal("expected an error, got none")
+			}
+			if !c.expectError && err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+		})
+	}
+}
+
 func TestAccFastlyServiceVCL_logging_sftp_basic(t *testing.T) {
 	var service gofastly.ServiceDetail
 	name := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
@@ -102,7 +132,7 @@ func TestAccFastlyServiceVCL_logging_sftp_basic(t *testing.T) {
 		GzipLevel:       0,
 		TimestampFormat: "%Y-%m-%dT%H:%M:%S.000",
 		FormatVersion:   2,
-		Format:          "%h %l %u %t \"%r\" %>s %b",
+		Format:          appendNewLine("%h %l %u %t \"%r\" %>s %b"),
 	}
 
 	log1_after_update := gofastly.SFTP{
@@ -116,7 +146,7 @@ func TestAccFastlyServiceVCL_logging_sftp_basic(t *testing.T) {
 		SSHKnownHosts:     "sftp.example.com",
 		MessageType:       "blank",
 		Port:              2600,
-		Format:            "%h %l %u %t \"%r\" %>s %b %T",
+		Format:            appendNewLine("%h %l %u %t \"%r\" %>s %b %T"),
 		Placement:         "waf_debug",
 		ResponseCondition: "response_condition_test",
 		GzipLevel:         3,
@@ -147,7 +177,7 @@ func TestAccFastlyServiceVCL_logging_sftp_basic(t *testing.T) {
 		GzipLevel:       0,
 		TimestampFormat: "%Y-%m-%dT%H:%M:%S.000",
 		FormatVersion:   2,
-		Format:          "%h %l %u %t \"%r\" %>s %b",
+		Format:          appendNewLine("%h %l %u %t \"%r\" %>s %b"),
 	}
 
 	resource.ParallelTest(t, resource.TestCase{
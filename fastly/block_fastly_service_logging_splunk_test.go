@@ -84,7 +84,7 @@ func TestAccFastlyServiceVCL_splunk_basic(t *testing.T) {
 		Name:              "test-splunk-1",
 		URL:               "https://mysplunkendpoint.example.com/services/collector/event",
 		Token:             "test-token",
-		Format:            "%h %l %u %t \"%r\" %>s %b",
+		Format:            appendNewLine("%h %l %u %t \"%r\" %>s %b"),
 		FormatVersion:     1,
 		Placement:         "waf_debug",
 		ResponseCondition: "error_response_5XX",
@@ -95,7 +95,7 @@ func TestAccFastlyServiceVCL_splunk_basic(t *testing.T) {
 		Name:              "test-splunk-1",
 		URL:               "https://mysplunkendpoint.example.com/services/collector/event",
 		Token:             "test-token",
-		Format:            "%h %l %u %{now}V %{req.method}V %{req.url}V %>s %{resp.http.Content-Length}V",
+		Format:            appendNewLine("%h %l %u %{now}V %{req.method}V %{req.url}V %>s %{resp.http.Content-Length}V"),
 		FormatVersion:     2,
 		Placement:         "waf_debug",
 		ResponseCondition: "error_response_5XX",
@@ -106,7 +106,7 @@ func TestAccFastlyServiceVCL_splunk_basic(t *testing.T) {
 		Name:              "test-splunk-2",
 		URL:               "https://mysplunkendpoint.example.com/services/collector/event",
 		Token:             "test-token",
-		Format:            "%h %l %u %{now}V %{req.method}V %{req.url}V %>s %{resp.http.Content-Length}V",
+		Format:            appendNewLine("%h %l %u %{now}V %{req.method}V %{req.url}V %>s %{resp.http.Content-Length}V"),
 		FormatVersion:     2,
 		Placement:         "waf_debug",
 		ResponseCondition: "ok_response_2XX",
@@ -183,7 +183,7 @@ func TestAccFastlyServiceVCL_splunk_default(t *testing.T) {
 		Name:          "test-splunk",
 		URL:           "https://mysplunkendpoint.example.com/services/collector/event",
 		Token:         "test-token",
-		Format:        "%h %l %u %t \"%r\" %>s %b",
+		Format:        appendNewLine("%h %l %u %t \"%r\" %>s %b"),
 		FormatVersion: 2,
 	}
 
@@ -220,7 +220,7 @@ func TestAccFastlyServiceVCL_splunk_complete(t *testing.T) {
 		Name:              "test-splunk-1",
 		URL:               "https://mysplunkendpoint.example.com/services/collector/event",
 		Token:             "test-token",
-		Format:            "%h %l %u %t \"%r\" %>s %b",
+		Format:            appendNewLine("%h %l %u %t \"%r\" %>s %b"),
 		FormatVersion:     1,
 		Placement:         "waf_debug",
 		ResponseCondition: "error_response_5XX",
@@ -239,7 +239,7 @@ func TestAccFastlyServiceVCL_splunk_complete(t *testing.T) {
 		Name:              "test-splunk-1",
 		URL:               "https://mysplunkendpoint.example.com/services/collector/event",
 		Token:             "test-token",
-		Format:            "%h %l %u %{now}V %{req.method}V %{req.url}V %>s %{resp.http.Content-Length}V",
+		Format:            appendNewLine("%h %l %u %{now}V %{req.method}V %{req.url}V %>s %{resp.http.Content-Length}V"),
 		FormatVersion:     2,
 		Placement:         "waf_debug",
 		ResponseCondition: "error_response_5XX",
@@ -258,7 +258,7 @@ func TestAccFastlyServiceVCL_splunk_complete(t *testing.T) {
 		Name:              "test-splunk-2",
 		URL:               "https://mysplunkendpoint.example.com/services/collector/event",
 		Token:             "test-token",
-		Format:            "%h %l %u %{now}V %{req.method}V %{req.url}V %>s %{resp.http.Content-Length}V",
+		Format:            appendNewLine("%h %l %u %{now}V %{req.method}V %{req.url}V %>s %{resp.http.Content-Length}V"),
 		FormatVersion:     2,
 		Placement:         "waf_debug",
 		ResponseCondition: "ok_response_2XX",
@@ -33,9 +33,11 @@ func (h *SumologicServiceAttributeHandler) GetSchema() *schema.Schema {
 			Description: "A unique name to identify this Sumologic endpoint. It is important to note that changing this attribute will delete and recreate the resource",
 		},
 		"url": {
-			Type:        schema.TypeString,
-			Required:    true,
-			Description: "The URL to Sumologic collector endpoint",
+			Type:             schema.TypeString,
+			Required:         true,
+			Sensitive:        true,
+			Description:      "The URL to Sumologic collector endpoint. It is marked as sensitive because it contains the collector's unique secret. The Sumo Logic deployment is part of the host, e.g. `endpoint1.collection.eu.sumologic.com` for EU, so use the URL of the HTTP source as shown by Sumo Logic",
+			ValidateDiagFunc: validateLoggingSumologicURL(),
 		},
 		// Optional fields
 		"message_type": {
@@ -49,10 +51,17 @@ func (h *SumologicServiceAttributeHandler) GetSchema() *schema.Schema {
 
 	if h.GetServiceMetadata().serviceType == ServiceTypeVCL {
 		blockAttributes["format"] = &schema.Schema{
-			Type:        schema.TypeString,
+			Type:             schema.TypeString,
+			Optional:         true,
+			Default:          `%h %l %u %t "%r" %>s %b`,
+			Description:      "Apache-style string or VCL variables to use for log formatting",
+			ValidateDiagFunc: validateLoggingFormat(),
+		}
+		blockAttributes["append_newline"] = &schema.Schema{
+			Type:        schema.TypeBool,
 			Optional:    true,
-			Default:     `%h %l %u %t "%r" %>s %b`,
-			Description: "Apache-style string or VCL variables to use for log formatting",
+			Default:     true,
+			Description: loggingAppendNewlineDescription,
 		}
 		blockAttributes["format_version"] = &schema.Schema{
 			Type:             schema.TypeInt,
@@ -122,6 +131,8 @@ func (h *SumologicServiceAttributeHandler) Read(_ context.Context, d *schema.Res
 
 	for _, element := range sul {
 		element = h.pruneVCLLoggingAttributes(element)
+		h.restoreLoggingFormat(d, element)
+		h.restoreLoggingPlacement(d, element)
 	}
 
 	if err := d.Set(h.GetKey(), sul); err != nil {
@@ -143,14 +154,11 @@ func (h *SumologicServiceAttributeHandler) Update(_ context.Context, d *schema.R
 	// materializes as a panic (yay) and so it's only at runtime we discover
 	// this and so we've updated the below code to convert the type asserted
 	// int into a uint before passing the value to gofastly.Uint().
-	if v, ok := modified["address"]; ok {
-		opts.Address = gofastly.String(v.(string))
-	}
 	if v, ok := modified["url"]; ok {
 		opts.URL = gofastly.String(v.(string))
 	}
-	if v, ok := modified["format"]; ok {
-		opts.Format = gofastly.String(v.(string))
+	if v := h.modifiedLoggingFormat(resource, modified); v != nil {
+		opts.Format = v
 	}
 	if v, ok := modified["response_condition"]; ok {
 		opts.ResponseCondition = gofastly.String(v.(string))
@@ -56,16 +56,16 @@ func TestAccFastlyServiceVCL_sumologic(t *testing.T) {
 
 	s := gofastly.Sumologic{
 		Name:          "sumologger",
-		URL:           "https://collectors.sumologic.com/receiver/1",
+		URL:           "https://endpoint1.collection.sumologic.com/receiver/v1/http/1",
 		FormatVersion: 2,
-		Format:        "my format",
+		Format:        appendNewLine("my format"),
 	}
 
 	sn := gofastly.Sumologic{
 		Name:          "sumologger",
-		URL:           "https://collectors.sumologic.com/receiver/1",
+		URL:           "https://endpoint1.collection.sumologic.com/receiver/v1/http/1",
 		FormatVersion: 2,
-		Format:        "my format new",
+		Format:        appendNewLine("my format new"),
 	}
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -107,7 +107,7 @@ func TestAccFastlyServiceVCL_sumologic_compute(t *testing.T) {
 
 	s := gofastly.Sumologic{
 		Name: "sumologger",
-		URL:  "https://collectors.sumologic.com/receiver/1",
+		URL:  "https://endpoint1.collection.sumologic.com/receiver/v1/http/1",
 	}
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -10,6 +10,7 @@ import (
 	gofastly "github.com/fastly/go-fastly/v6/fastly"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
@@ -83,7 +84,7 @@ func TestAccFastlyServiceVCL_syslog_basic(t *testing.T) {
 		Address:           "127.0.0.1",
 		IPV4:              "127.0.0.1",
 		Port:              uint(514),
-		Format:            `%h %l %u %t "%r" %>s %b`,
+		Format:            appendNewLine(`%h %l %u %t "%r" %>s %b`),
 		FormatVersion:     2,
 		ResponseCondition: "response_condition_test",
 		MessageType:       "classic",
@@ -95,7 +96,7 @@ func TestAccFastlyServiceVCL_syslog_basic(t *testing.T) {
 		Address:           "127.0.0.1",
 		IPV4:              "127.0.0.1",
 		Port:              uint(514),
-		Format:            `%h %l %u %t "%r" %>s %b`,
+		Format:            appendNewLine(`%h %l %u %t "%r" %>s %b`),
 		FormatVersion:     2,
 		ResponseCondition: "response_condition_test",
 		MessageType:       "blank",
@@ -107,7 +108,7 @@ func TestAccFastlyServiceVCL_syslog_basic(t *testing.T) {
 		Address:        "127.0.0.2",
 		IPV4:           "127.0.0.2",
 		Port:           uint(10514),
-		Format:         `%h %l %u %t "%r" %>s %b`,
+		Format:         appendNewLine(`%h %l %u %t "%r" %>s %b`),
 		FormatVersion:  2,
 		MessageType:    "classic",
 	}
@@ -190,7 +191,7 @@ func TestAccFastlyServiceVCL_syslog_formatVersion(t *testing.T) {
 		Address:        "127.0.0.1",
 		IPV4:           "127.0.0.1",
 		Port:           uint(514),
-		Format:         "%a %l %u %t %m %U%q %H %>s %b %T",
+		Format:         appendNewLine("%a %l %u %t %m %U%q %H %>s %b %T"),
 		FormatVersion:  2,
 		MessageType:    "classic",
 	}
@@ -236,7 +237,7 @@ func TestAccFastlyServiceVCL_syslog_useTLS(t *testing.T) {
 		Address:        "127.0.0.1",
 		IPV4:           "127.0.0.1",
 		Port:           uint(514),
-		Format:         `%h %l %u %t "%r" %>s %b`,
+		Format:         appendNewLine(`%h %l %u %t "%r" %>s %b`),
 		FormatVersion:  2,
 		MessageType:    "classic",
 		UseTLS:         true,
@@ -266,6 +267,107 @@ func TestAccFastlyServiceVCL_syslog_useTLS(t *testing.T) {
 	})
 }
 
+func TestResourceFastlyDefaultLoggingPlacement(t *testing.T) {
+	for _, c := range []struct {
+		name            string
+		handler         ServiceAttributeDefinition
+		syslogs         []interface{}
+		expectedDefault string
+		expectedChanged bool
+	}{
+		{
+			name:            "endpoint without placement",
+			handler:         NewServiceLoggingSyslog(ServiceMetadata{ServiceTypeVCL}),
+			syslogs:         []interface{}{map[string]interface{}{"name": "syslog", "address": "example.com"}},
+			expectedDefault: "none",
+			expectedChanged: true,
+		},
+		{
+			name:            "endpoint with placement",
+			handler:         NewServiceLoggingSyslog(ServiceMetadata{ServiceTypeVCL}),
+			syslogs:         []interface{}{map[string]interface{}{"name": "syslog", "address": "example.com", "placement": "waf_debug"}},
+			expectedDefault: "none",
+			expectedChanged: false,
+		},
+		{
+			name:    "not a logging endpoint",
+			handler: NewServiceBackend(ServiceMetadata{ServiceTypeVCL}),
+		},
+	} {
+		t.Run(c.name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, resourceServiceVCL().Schema, map[string]interface{}{
+				"name":                     "test",
+				defaultLoggingPlacementKey: "none",
+				"logging_syslog":           c.syslogs,
+			})
+			defaultPlacement, changed := c.handler.(*blockSetAttributeHandler).defaultLoggingPlacement(d)
+			if defaultPlacement != c.expectedDefault || changed != c.expectedChanged {
+				t.Fatalf("expected (%q, %t), got (%q, %t)", c.expectedDefault, c.expectedChanged, defaultPlacement, changed)
+			}
+		})
+	}
+}
+
+func TestAccFastlyServiceVCL_syslog_defaultLoggingPlacement(t *testing.T) {
+	var service gofastly.ServiceDetail
+	name := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	domainName1 := fmt.Sprintf("fastly-test.tf-%s.com", acctest.RandString(10))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckServiceVCLDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceVCLSyslogConfig_defaultLoggingPlacement(name, domainName1, `default_logging_placement = "none"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceVCLExists("fastly_service_vcl.foo", &service),
+					testAccCheckFastlyServiceVCLSyslogPlacements(&service, map[string]string{"somesyslogname": "none", "somesyslogothername": "none"}),
+				),
+			},
+			{
+				Config:   testAccServiceVCLSyslogConfig_defaultLoggingPlacement(name, domainName1, `default_logging_placement = "none"`),
+				PlanOnly: true,
+			},
+			{
+				ResourceName:      "fastly_service_vcl.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// default_logging_placement isn't stored on the Fastly API, so the endpoints are imported with the
+				// placement it gave them rather than an empty one.
+				ImportStateVerifyIgnore: []string{"activate", "force_destroy", "default_logging_placement", "logging_syslog"},
+			},
+			{
+				Config: testAccServiceVCLSyslogConfig_defaultLoggingPlacement(name, domainName1, ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceVCLExists("fastly_service_vcl.foo", &service),
+					testAccCheckFastlyServiceVCLSyslogPlacements(&service, map[string]string{"somesyslogname": "", "somesyslogothername": "none"}),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckFastlyServiceVCLSyslogPlacements(service *gofastly.ServiceDetail, placements map[string]string) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		conn := testAccProvider.Meta().(*FastlyClient).conn
+		syslogList, err := conn.ListSyslogs(&gofastly.ListSyslogsInput{
+			ServiceID:      service.ID,
+			ServiceVersion: service.ActiveVersion.Number,
+		})
+		if err != nil {
+			return fmt.Errorf("[ERR] Error looking up Syslog Logging for (%s), version (%d): %s", service.Name, service.ActiveVersion.Number, err)
+		}
+
+		for _, sl := range syslogList {
+			if expected, ok := placements[sl.Name]; ok && sl.Placement != expected {
+				return fmt.Errorf("Bad placement for Syslog (%s), expected (%q), got (%q)", sl.Name, expected, sl.Placement)
+			}
+		}
+		return nil
+	}
+}
+
 func testAccCheckFastlyServiceVCLSyslogAttributes(service *gofastly.ServiceDetail, syslogs []*gofastly.Syslog, serviceType string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 
@@ -372,6 +474,32 @@ resource "fastly_service_vcl" "foo" {
 }`, name, domain)
 }
 
+func testAccServiceVCLSyslogConfig_defaultLoggingPlacement(name, domain, defaultLoggingPlacement string) string {
+	return fmt.Sprintf(`
+resource "fastly_service_vcl" "foo" {
+  name = "%s"
+  domain {
+    name    = "%s"
+    comment = "tf-testing-domain"
+  }
+  backend {
+    address = "aws.amazon.com"
+    name    = "amazon docs"
+  }
+  %s
+  logging_syslog {
+    name    = "somesyslogname"
+    address = "127.0.0.1"
+  }
+  logging_syslog {
+    name      = "somesyslogothername"
+    address   = "127.0.0.2"
+    placement = "none"
+  }
+  force_destroy = true
+}`, name, domain, defaultLoggingPlacement)
+}
+
 func testAccServiceVCLSyslogConfig_update(name, domain string) string {
 	return fmt.Sprintf(`
 resource "fastly_service_vcl" "foo" {
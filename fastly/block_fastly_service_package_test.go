@@ -5,11 +5,35 @@ import (
 	"testing"
 
 	gofastly "github.com/fastly/go-fastly/v6/fastly"
+	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
+func TestResourceFastlyFlattenPackage(t *testing.T) {
+	remote := &gofastly.Package{
+		Metadata: gofastly.PackageMetadata{
+			Name:     "wasm-test",
+			Language: "rust",
+			Size:     2015936,
+			HashSum:  "f99485bd301e23f028474d26d398da525de17a372ae9e7026891d7f85361d2540d14b3b091929c3f170eade573595e20b3405a9e29651ede59915f2e1652f616",
+		},
+	}
+	// The filename isn't stored by the API, so it is carried over from the config.
+	local := []map[string]interface{}{
+		{
+			"filename":         "test_fixtures/package/valid.tar.gz",
+			"source_code_hash": "f99485bd301e23f028474d26d398da525de17a372ae9e7026891d7f85361d2540d14b3b091929c3f170eade573595e20b3405a9e29651ede59915f2e1652f616",
+		},
+	}
+
+	out := flattenPackage(remote, "test_fixtures/package/valid.tar.gz")
+	if diff := cmp.Diff(local, out); diff != "" {
+		t.Fatalf("Error matching: %s", diff)
+	}
+}
+
 func TestAccFastlyServiceVCL_package_basic(t *testing.T) {
 	var service gofastly.ServiceDetail
 	name01 := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
@@ -45,9 +45,10 @@ func (h *RequestSettingServiceAttributeHandler) GetSchema() *schema.Schema {
 					Description: "Name of already defined `condition` to determine if this request setting should be applied",
 				},
 				"max_stale_age": {
-					Type:        schema.TypeInt,
-					Optional:    true,
-					Description: "How old an object is allowed to be to serve `stale-if-error` or `stale-while-revalidate`, in seconds",
+					Type:             schema.TypeInt,
+					Optional:         true,
+					Description:      "How old an object is allowed to be to serve `stale-if-error` or `stale-while-revalidate`, in seconds",
+					ValidateDiagFunc: validateRequestSettingMaxStaleAge(),
 				},
 				"force_miss": {
 					Type:        schema.TypeBool,
@@ -60,25 +61,28 @@ func (h *RequestSettingServiceAttributeHandler) GetSchema() *schema.Schema {
 					Description: "Forces the request to use SSL (Redirects a non-SSL request to SSL)",
 				},
 				"action": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Allows you to terminate request handling and immediately perform an action. When set it can be `lookup` or `pass` (Ignore the cache completely)",
+					Type:             schema.TypeString,
+					Optional:         true,
+					Description:      "Allows you to terminate request handling and immediately perform an action. When set it can be `lookup` or `pass` (Ignore the cache completely)",
+					ValidateDiagFunc: validateRequestSettingAction(),
 				},
 				"bypass_busy_wait": {
 					Type:        schema.TypeBool,
 					Optional:    true,
-					Description: "Disable collapsed forwarding, so you don't wait for other objects to origin",
+					Description: "Disable collapsed forwarding, so requests for the same object go to origin without waiting for a request already in flight. Default `false`",
 				},
 				"hash_keys": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Comma separated list of varnish request object fields that should be in the hash key",
+					Type:             schema.TypeString,
+					Optional:         true,
+					Description:      "Comma separated list of varnish request object fields that should be in the hash key",
+					ValidateDiagFunc: validateRequestSettingHashKeys(),
 				},
 				"xff": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "append",
-					Description: "X-Forwarded-For, should be `clear`, `leave`, `append`, `append_all`, or `overwrite`. Default `append`",
+					Type:             schema.TypeString,
+					Optional:         true,
+					Default:          "append",
+					Description:      "X-Forwarded-For, should be `clear`, `leave`, `append`, `append_all`, or `overwrite`. Default `append`",
+					ValidateDiagFunc: validateRequestSettingXFF(),
 				},
 				"timer_support": {
 					Type:        schema.TypeBool,
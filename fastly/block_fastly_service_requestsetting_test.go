@@ -8,6 +8,7 @@ import (
 	gofastly "github.com/fastly/go-fastly/v6/fastly"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
@@ -44,6 +45,29 @@ func TestResourceFastlyFlattenRequestSettings(t *testing.T) {
 				},
 			},
 		},
+		{
+			remote: []*gofastly.RequestSetting{
+				{
+					Name:           "collapsing_opt_out",
+					XForwardedFor:  gofastly.RequestSettingXFFClear,
+					Action:         gofastly.RequestSettingActionLookup,
+					BypassBusyWait: true,
+				},
+			},
+			local: []map[string]interface{}{
+				{
+					"name":             "collapsing_opt_out",
+					"xff":              gofastly.RequestSettingXFFClear,
+					"max_stale_age":    uint(0),
+					"action":           gofastly.RequestSettingActionLookup,
+					"bypass_busy_wait": true,
+					"force_miss":       false,
+					"force_ssl":        false,
+					"geo_headers":      false,
+					"timer_support":    false,
+				},
+			},
+		},
 	}
 
 	for _, c := range cases {
@@ -73,6 +97,7 @@ func TestAccFastlyServiceVCLRequestSetting_basic(t *testing.T) {
 		DefaultHost:      "tftestingother.tftesting.net.s3-website-us-west-2.amazonaws.com",
 		XForwardedFor:    "append",
 		MaxStaleAge:      uint(900),
+		BypassBusyWait:   true,
 	}
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -81,7 +106,7 @@ func TestAccFastlyServiceVCLRequestSetting_basic(t *testing.T) {
 		CheckDestroy:      testAccCheckServiceVCLDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccServiceVCLRequestSetting(name, domainName1, "90"),
+				Config: testAccServiceVCLRequestSetting(name, domainName1, "90", false),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckServiceVCLExists("fastly_service_vcl.foo", &service),
 					testAccCheckFastlyServiceVCLRequestSettingsAttributes(&service, []*gofastly.RequestSetting{&rq1}),
@@ -94,7 +119,7 @@ func TestAccFastlyServiceVCLRequestSetting_basic(t *testing.T) {
 				),
 			},
 			{
-				Config: testAccServiceVCLRequestSetting(name, domainName1, "900"),
+				Config: testAccServiceVCLRequestSetting(name, domainName1, "900", true),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckServiceVCLExists("fastly_service_vcl.foo", &service),
 					testAccCheckFastlyServiceVCLRequestSettingsAttributes(&service, []*gofastly.RequestSetting{&rq2}),
@@ -154,7 +179,7 @@ func testAccCheckFastlyServiceVCLRequestSettingsAttributes(service *gofastly.Ser
 	}
 }
 
-func testAccServiceVCLRequestSetting(name, domain, maxStaleAge string) string {
+func testAccServiceVCLRequestSetting(name, domain, maxStaleAge string, bypassBusyWait bool) string {
 	return fmt.Sprintf(`
 resource "fastly_service_vcl" "foo" {
   name = "%s"
@@ -188,10 +213,31 @@ resource "fastly_service_vcl" "foo" {
     name              = "alt_backend"
     request_condition = "serve_alt_backend"
     max_stale_age     = %s
+    bypass_busy_wait  = %t
   }
 
   default_host = "tftesting.tftesting.net.s3-website-us-west-2.amazonaws.com"
 
   force_destroy = true
-}`, name, domain, maxStaleAge)
+}`, name, domain, maxStaleAge, bypassBusyWait)
+}
+
+func TestResourceFastlyFlattenRequestSettingsOrder(t *testing.T) {
+	remote := []*gofastly.RequestSetting{
+		{Name: "b_setting", Action: gofastly.RequestSettingActionPass, MaxStaleAge: 90},
+		{Name: "a_setting", XForwardedFor: gofastly.RequestSettingXFFAppend},
+	}
+	reversed := []*gofastly.RequestSetting{remote[1], remote[0]}
+
+	// request_setting is a set, so the order the API returns the settings in doesn't change what is saved to state.
+	state := func(settings []*gofastly.RequestSetting) *schema.Set {
+		d := schema.TestResourceDataRaw(t, resourceServiceVCL().Schema, map[string]interface{}{})
+		if err := d.Set("request_setting", flattenRequestSettings(settings)); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		return d.Get("request_setting").(*schema.Set)
+	}
+	if !state(remote).Equal(state(reversed)) {
+		t.Fatalf("Error matching: expected the same state regardless of the API order")
+	}
 }
@@ -6,9 +6,14 @@ import (
 	"log"
 
 	gofastly "github.com/fastly/go-fastly/v6/fastly"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
+// responseObjectGzipWarningSize is the content size, in bytes, from which we warn that a response object won't be
+// compressed by the service's gzip rules.
+const responseObjectGzipWarningSize = 1024
+
 type ResponseObjectServiceAttributeHandler struct {
 	*DefaultServiceAttributeHandler
 }
@@ -38,10 +43,11 @@ func (h *ResponseObjectServiceAttributeHandler) GetSchema() *schema.Schema {
 				},
 				// Optional fields
 				"status": {
-					Type:        schema.TypeInt,
-					Optional:    true,
-					Default:     200,
-					Description: "The HTTP Status Code. Default `200`",
+					Type:             schema.TypeInt,
+					Optional:         true,
+					Default:          200,
+					Description:      "The HTTP Status Code. Default `200`",
+					ValidateDiagFunc: validateResponseObjectStatus(),
 				},
 				"response": {
 					Type:        schema.TypeString,
@@ -53,7 +59,7 @@ func (h *ResponseObjectServiceAttributeHandler) GetSchema() *schema.Schema {
 					Type:        schema.TypeString,
 					Optional:    true,
 					Default:     "",
-					Description: "The content to deliver for the response object",
+					Description: "The content to deliver for the response object. Note that synthetic responses are not compressed by `gzip` rules",
 				},
 				"content_type": {
 					Type:        schema.TypeString,
@@ -205,3 +211,33 @@ func flattenResponseObjects(responseObjectList []*gofastly.ResponseObject) []map
 
 	return rol
 }
+
+// responseObjectGzipWarnings returns a warning for every large response object of a service which also defines gzip
+// rules. Fastly delivers response objects as synthetic responses, which gzip rules never apply to, and there is no API
+// to opt a response object in to compression.
+func responseObjectGzipWarnings(d *schema.ResourceData) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	gzips, ok := d.Get("gzip").(*schema.Set)
+	if !ok || gzips.Len() == 0 {
+		return diags
+	}
+
+	responseObjects, ok := d.Get("response_object").(*schema.Set)
+	if !ok {
+		return diags
+	}
+
+	for _, elem := range responseObjects.List() {
+		m := elem.(map[string]interface{})
+		if content, ok := m["content"].(string); ok && len(content) >= responseObjectGzipWarningSize {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  "Response object is not compressed by gzip rules",
+				Detail:   fmt.Sprintf("Response object '%s' has %d bytes of content, but response objects are synthetic responses which the service's gzip rules do not apply to. Consider serving large bodies from a backend instead", m["name"], len(content)),
+			})
+		}
+	}
+
+	return diags
+}
@@ -3,11 +3,13 @@ package fastly
 import (
 	"fmt"
 	"reflect"
+	"strings"
 	"testing"
 
 	gofastly "github.com/fastly/go-fastly/v6/fastly"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
@@ -53,6 +55,49 @@ func TestResourceFastlyFlattenResponseObjects(t *testing.T) {
 
 }
 
+func TestResourceFastlyResponseObjectGzipWarnings(t *testing.T) {
+	largeContent := strings.Repeat("x", responseObjectGzipWarningSize)
+
+	for _, c := range []struct {
+		name     string
+		raw      map[string]interface{}
+		expected int
+	}{
+		{
+			name: "large response object with gzip",
+			raw: map[string]interface{}{
+				"name": "test",
+				"gzip": []interface{}{
+					map[string]interface{}{"name": "gzip"},
+				},
+				"response_object": []interface{}{
+					map[string]interface{}{"name": "large", "content": largeContent},
+					map[string]interface{}{"name": "small", "content": "small"},
+				},
+			},
+			expected: 1,
+		},
+		{
+			name: "large response object without gzip",
+			raw: map[string]interface{}{
+				"name": "test",
+				"response_object": []interface{}{
+					map[string]interface{}{"name": "large", "content": largeContent},
+				},
+			},
+			expected: 0,
+		},
+	} {
+		t.Run(c.name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, resourceServiceVCL().Schema, c.raw)
+			diags := responseObjectGzipWarnings(d)
+			if len(diags) != c.expected {
+				t.Fatalf("expected %d warnings, got %d: %#v", c.expected, len(diags), diags)
+			}
+		})
+	}
+}
+
 func TestAccFastlyServiceVCL_response_object_basic(t *testing.T) {
 	var service gofastly.ServiceDetail
 	name := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
@@ -70,10 +70,11 @@ func (h *SettingsServiceAttributeHandler) MustProcess(d *schema.ResourceData, in
 
 func (h *SettingsServiceAttributeHandler) Register(s *schema.Resource) error {
 	s.Schema["default_ttl"] = &schema.Schema{
-		Type:        schema.TypeInt,
-		Optional:    true,
-		Default:     3600,
-		Description: "The default Time-to-live (TTL) for requests",
+		Type:             schema.TypeInt,
+		Optional:         true,
+		Default:          3600,
+		Description:      "The default Time-to-live (TTL) for requests",
+		ValidateDiagFunc: validateTTL(),
 	}
 	s.Schema["default_host"] = &schema.Schema{
 		Type:        schema.TypeString,
@@ -87,10 +88,11 @@ func (h *SettingsServiceAttributeHandler) Register(s *schema.Resource) error {
 		Description: "Enables serving a stale object if there is an error",
 	}
 	s.Schema["stale_if_error_ttl"] = &schema.Schema{
-		Type:        schema.TypeInt,
-		Optional:    true,
-		Default:     43200,
-		Description: "The default time-to-live (TTL) for serving the stale object for the version",
+		Type:             schema.TypeInt,
+		Optional:         true,
+		Default:          43200,
+		Description:      "The default time-to-live (TTL) for serving the stale object for the version",
+		ValidateDiagFunc: validateTTL(),
 	}
 	return nil
 }
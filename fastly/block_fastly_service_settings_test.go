@@ -0,0 +1,67 @@
+package fastly
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"testing"
+
+	gofastly "github.com/fastly/go-fastly/v6/fastly"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestResourceFastlySettingsRoundTrip(t *testing.T) {
+	// The fake API stores the settings it is sent, starting from the API defaults, and returns them when read.
+	stored := map[string]interface{}{
+		"general.default_host":       "",
+		"general.default_ttl":        3600,
+		"general.stale_if_error":     false,
+		"general.stale_if_error_ttl": 43200,
+	}
+	conn := testFastlyClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/service/123/version/1/settings" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		if r.Method == http.MethodPut {
+			if err := r.ParseForm(); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			for k, v := range r.PostForm {
+				switch stored[k].(type) {
+				case int:
+					stored[k], _ = strconv.Atoi(v[0])
+				case bool:
+					stored[k], _ = strconv.ParseBool(v[0])
+				default:
+					stored[k] = v[0]
+				}
+			}
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(stored)
+	})
+
+	config := map[string]interface{}{
+		"name":         "test",
+		"default_host": "tftesting.tftesting.net.s3-website-us-west-2.amazonaws.com",
+		"default_ttl":  3400,
+	}
+	d := schema.TestResourceDataRaw(t, resourceServiceVCL().Schema, config)
+	d.SetId("123")
+	if err := NewServiceSettings().Process(context.Background(), d, 1, conn); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// Both settings are read back from the API, so neither is left at its schema default.
+	refreshed := schema.TestResourceDataRaw(t, resourceServiceVCL().Schema, map[string]interface{}{"name": "test"})
+	refreshed.SetId("123")
+	if err := NewServiceSettings().Read(context.Background(), refreshed, &gofastly.ServiceDetail{ActiveVersion: gofastly.Version{Number: 1}}, conn); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	for _, k := range []string{"default_host", "default_ttl"} {
+		if got := refreshed.Get(k); got != config[k] {
+			t.Errorf("expected %s to be %v, got %v", k, config[k], got)
+		}
+	}
+}
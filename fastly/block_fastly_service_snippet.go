@@ -198,3 +198,26 @@ func flattenSnippets(snippetList []*gofastly.Snippet) []map[string]interface{} {
 
 	return sl
 }
+
+// validateSnippetNames returns an error if a name is used by more than one snippet, counting both regular and dynamic
+// snippets. The API requires snippet names to be unique across both kinds, and otherwise only rejects them on apply.
+func validateSnippetNames(snippets, dynamicSnippets *schema.Set) error {
+	names := map[string]int{}
+	for _, set := range []*schema.Set{snippets, dynamicSnippets} {
+		for _, elem := range set.List() {
+			name := elem.(map[string]interface{})["name"].(string)
+			if name == "" {
+				// The name isn't known until apply.
+				return nil
+			}
+			names[name]++
+		}
+	}
+
+	for name, count := range names {
+		if count > 1 {
+			return fmt.Errorf("snippet name %q is used by %d snippet and dynamicsnippet blocks, snippet names must be unique across both", name, count)
+		}
+	}
+	return nil
+}
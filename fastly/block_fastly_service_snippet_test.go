@@ -8,6 +8,7 @@ import (
 	gofastly "github.com/fastly/go-fastly/v6/fastly"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
@@ -58,6 +59,51 @@ func TestResourceFastlyFlattenSnippets(t *testing.T) {
 
 }
 
+func TestResourceFastlyValidateSnippetNames(t *testing.T) {
+	snippetElem := resourceServiceVCL().Schema["snippet"].Elem.(*schema.Resource)
+	dynamicSnippetElem := resourceServiceVCL().Schema["dynamicsnippet"].Elem.(*schema.Resource)
+
+	for _, c := range []struct {
+		name            string
+		snippets        []interface{}
+		dynamicSnippets []interface{}
+		expectError     bool
+	}{
+		{
+			name:            "unique names",
+			snippets:        []interface{}{map[string]interface{}{"name": "recv_test", "type": "recv", "content": "", "priority": 100}},
+			dynamicSnippets: []interface{}{map[string]interface{}{"name": "recv_dynamic_test", "type": "recv", "priority": 100}},
+		},
+		{
+			name:            "name used by a snippet and a dynamic snippet",
+			snippets:        []interface{}{map[string]interface{}{"name": "recv_test", "type": "recv", "content": "", "priority": 100}},
+			dynamicSnippets: []interface{}{map[string]interface{}{"name": "recv_test", "type": "recv", "priority": 100}},
+			expectError:     true,
+		},
+		{
+			name: "name used by two snippets",
+			snippets: []interface{}{
+				map[string]interface{}{"name": "recv_test", "type": "recv", "content": "a", "priority": 100},
+				map[string]interface{}{"name": "recv_test", "type": "recv", "content": "b", "priority": 100},
+			},
+			expectError: true,
+		},
+	} {
+		t.Run(c.name, func(t *testing.T) {
+			err := validateSnippetNames(
+				schema.NewSet(schema.HashResource(snippetElem), c.snippets),
+				schema.NewSet(schema.HashResource(dynamicSnippetElem), c.dynamicSnippets),
+			)
+			if c.expectError && err == nil {
+				t.Fatal("expected an error, got none")
+			}
+			if !c.expectError && err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+		})
+	}
+}
+
 func TestAccFastlyServiceVCLSnippet_basic(t *testing.T) {
 	var service gofastly.ServiceDetail
 	name := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
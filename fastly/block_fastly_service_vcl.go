@@ -45,13 +45,24 @@ func (h *VCLServiceAttributeHandler) GetSchema() *schema.Schema {
 					Type:        schema.TypeBool,
 					Optional:    true,
 					Default:     false,
-					Description: "If `true`, use this block as the main configuration. If `false`, use this block as an includable library. Only a single VCL block can be marked as the main block. Default is `false`",
+					Description: "If `true`, use this block as the main configuration. If `false`, use this block as an includable library. Only a single VCL block can be marked as the main block. Includable blocks are uploaded before the main block. Default is `false`",
 				},
 			},
 		},
 	}
 }
 
+// Less orders includable VCLs before the main VCL, so that the files it includes have already been uploaded by the
+// time it is, and otherwise orders VCLs by name so that uploads happen in a deterministic order.
+func (h *VCLServiceAttributeHandler) Less(a, b map[string]interface{}) bool {
+	aMain, _ := a["main"].(bool)
+	bMain, _ := b["main"].(bool)
+	if aMain != bMain {
+		return bMain
+	}
+	return a["name"].(string) < b["name"].(string)
+}
+
 func (h *VCLServiceAttributeHandler) Create(_ context.Context, d *schema.ResourceData, resource map[string]interface {
 }, serviceVersion int, conn *gofastly.Client) error {
 	opts := gofastly.CreateVCLInput{
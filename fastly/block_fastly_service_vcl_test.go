@@ -44,6 +44,32 @@ func TestResourceFastlyFlattenVCLs(t *testing.T) {
 
 }
 
+func TestResourceFastlyVCLUploadOrder(t *testing.T) {
+	h := &VCLServiceAttributeHandler{
+		&DefaultServiceAttributeHandler{
+			key:             "vcl",
+			serviceMetadata: ServiceMetadata{serviceType: ServiceTypeVCL},
+		},
+	}
+
+	vcls := []interface{}{
+		map[string]interface{}{"name": "b_include", "main": false},
+		map[string]interface{}{"name": "a_main", "main": true},
+		map[string]interface{}{"name": "c_include", "main": false},
+		map[string]interface{}{"name": "a_include", "main": false},
+	}
+	sortSetElements(vcls, h.Less)
+
+	var names []string
+	for _, v := range vcls {
+		names = append(names, v.(map[string]interface{})["name"].(string))
+	}
+	expected := []string{"a_include", "b_include", "c_include", "a_main"}
+	if !reflect.DeepEqual(names, expected) {
+		t.Fatalf("Error matching upload order:\nexpected: %#v\n got: %#v", expected, names)
+	}
+}
+
 func TestAccFastlyServiceVCL_VCL_basic(t *testing.T) {
 	var service gofastly.ServiceDetail
 	name := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
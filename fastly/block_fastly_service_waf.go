@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"sort"
+	"strings"
 
 	gofastly "github.com/fastly/go-fastly/v6/fastly"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -201,3 +203,34 @@ func buildUpdateWAF(d *schema.ResourceData, wafMap interface{}, serviceID string
 
 	return &input
 }
+
+// validateWAFResponseObject returns an error if the waf references a response object that isn't defined in the
+// service. The WAF delivers that response object for blocked requests, and the API only rejects an unknown one once
+// the new service version has been cloned and partly updated.
+func validateWAFResponseObject(wafs []interface{}, responseObjects *schema.Set) error {
+	if len(wafs) == 0 || wafs[0] == nil {
+		return nil
+	}
+	name, _ := wafs[0].(map[string]interface{})["response_object"].(string)
+	if name == "" {
+		return nil
+	}
+
+	var available []string
+	for _, elem := range responseObjects.List() {
+		ro := elem.(map[string]interface{})["name"].(string)
+		if ro == "" {
+			// The name isn't known until apply.
+			return nil
+		}
+		if ro == name {
+			return nil
+		}
+		available = append(available, ro)
+	}
+	if len(available) == 0 {
+		return fmt.Errorf("waf references response_object %q, but no response objects are defined", name)
+	}
+	sort.Strings(available)
+	return fmt.Errorf("waf references response_object %q, which isn't defined. Available response objects: %s", name, strings.Join(available, ", "))
+}
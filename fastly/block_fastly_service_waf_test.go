@@ -8,6 +8,7 @@ import (
 	gofastly "github.com/fastly/go-fastly/v6/fastly"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
@@ -60,6 +61,37 @@ func TestResourceFastlyFlattenWAF(t *testing.T) {
 	}
 }
 
+func TestValidateWAFResponseObject(t *testing.T) {
+	for name, c := range map[string]struct {
+		waf             []interface{}
+		responseObjects []interface{}
+		expectError     bool
+	}{
+		"no waf": {
+			responseObjects: []interface{}{testResponseObject("WAF_Response", "", "")},
+		},
+		"defined response object": {
+			waf:             []interface{}{map[string]interface{}{"response_object": "WAF_Response"}},
+			responseObjects: []interface{}{testResponseObject("WAF_Response", "", ""), testResponseObject("Maintenance", "", "")},
+		},
+		"undefined response object": {
+			waf:             []interface{}{map[string]interface{}{"response_object": "WAF_Respones"}},
+			responseObjects: []interface{}{testResponseObject("WAF_Response", "", "")},
+			expectError:     true,
+		},
+		"no response objects defined": {
+			waf:         []interface{}{map[string]interface{}{"response_object": "WAF_Response"}},
+			expectError: true,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			d := testServiceVCLData(t, map[string][]interface{}{"waf": c.waf, "response_object": c.responseObjects})
+			err := validateWAFResponseObject(d.Get("waf").([]interface{}), d.Get("response_object").(*schema.Set))
+			testExpectError(t, err, c.expectError)
+		})
+	}
+}
+
 func TestAccFastlyServiceVCLWAFAdd(t *testing.T) {
 	var service gofastly.ServiceDetail
 	name := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
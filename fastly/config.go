@@ -18,10 +18,15 @@ type Config struct {
 	UserAgent  string
 	NoAuth     bool
 	ForceHttp2 bool
+
+	WarnLoggingFormatVersion1 bool
 }
 
 type FastlyClient struct {
 	conn *gofastly.Client
+
+	// warnLoggingFormatVersion1 enables the format_version 1 migration warnings on service reads.
+	warnLoggingFormatVersion1 bool
 }
 
 func (c *Config) Client() (*FastlyClient, diag.Diagnostics) {
@@ -75,5 +80,6 @@ func (c *Config) Client() (*FastlyClient, diag.Diagnostics) {
 	}
 
 	client.conn = fastlyClient
+	client.warnLoggingFormatVersion1 = c.WarnLoggingFormatVersion1
 	return &client, nil
 }
@@ -61,6 +61,16 @@ func dataSourceFastlyTLSCertificate() *schema.Resource {
 				Description: "Timestamp (GMT) when the certificate was last updated",
 				Computed:    true,
 			},
+			"not_after": {
+				Type:        schema.TypeString,
+				Description: "Timestamp (GMT) when the certificate will expire",
+				Computed:    true,
+			},
+			"not_before": {
+				Type:        schema.TypeString,
+				Description: "Timestamp (GMT) when the certificate will become valid",
+				Computed:    true,
+			},
 			"replace": {
 				Type:        schema.TypeBool,
 				Description: "A recommendation from Fastly indicating the key associated with this certificate is in need of rotation",
@@ -213,6 +223,12 @@ func dataSourceFastlyTLSCertificateSetAttributes(certificate *fastly.CustomTLSCe
 	if err := d.Set("issuer", certificate.Issuer); err != nil {
 		return err
 	}
+	if err := d.Set("not_after", certificate.NotAfter.Format(time.RFC3339)); err != nil {
+		return err
+	}
+	if err := d.Set("not_before", certificate.NotBefore.Format(time.RFC3339)); err != nil {
+		return err
+	}
 	if err := d.Set("replace", certificate.Replace); err != nil {
 		return err
 	}
@@ -37,6 +37,10 @@ func TestAccFastlyDataSourceTLSCertificate_withName(t *testing.T) {
 						dataSourceName, "issued_to", resourceName, "issued_to"),
 					resource.TestCheckResourceAttrPair(
 						dataSourceName, "issuer", resourceName, "issuer"),
+					resource.TestCheckResourceAttrPair(
+						dataSourceName, "not_after", resourceName, "not_after"),
+					resource.TestCheckResourceAttrPair(
+						dataSourceName, "not_before", resourceName, "not_before"),
 					resource.TestCheckResourceAttrPair(
 						dataSourceName, "replace", resourceName, "replace"),
 					resource.TestCheckResourceAttrPair(
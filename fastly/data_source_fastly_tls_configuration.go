@@ -32,18 +32,18 @@ func dataSourceFastlyTLSConfiguration() *schema.Resource {
 			},
 			"tls_protocols": {
 				Type:          schema.TypeSet,
-				Description:   "TLS protocols available on the TLS configuration.",
+				Description:   "TLS protocols available on the TLS configuration, e.g. `1.2` and `1.3`.",
 				Optional:      true,
 				Computed:      true,
-				Elem:          &schema.Schema{Type: schema.TypeString},
+				Elem:          &schema.Schema{Type: schema.TypeString, ValidateDiagFunc: validateTLSProtocolFilter(tlsProtocols)},
 				ConflictsWith: []string{"id"},
 			},
 			"http_protocols": {
 				Type:          schema.TypeSet,
-				Description:   "HTTP protocols available on the TLS configuration.",
+				Description:   "HTTP protocols available on the TLS configuration, e.g. `http/1.1` and `http/2`.",
 				Optional:      true,
 				Computed:      true,
-				Elem:          &schema.Schema{Type: schema.TypeString},
+				Elem:          &schema.Schema{Type: schema.TypeString, ValidateDiagFunc: validateTLSProtocolFilter(tlsHTTPProtocols)},
 				ConflictsWith: []string{"id"},
 			},
 			"tls_service": {
@@ -104,6 +104,14 @@ const (
 	tlsCustomService   = "CUSTOM"
 )
 
+var (
+	// tlsProtocols are the TLS versions a TLS configuration is known to offer.
+	tlsProtocols = []string{"1.0", "1.1", "1.2", "1.3"}
+
+	// tlsHTTPProtocols are the HTTP versions a TLS configuration is known to negotiate.
+	tlsHTTPProtocols = []string{"http/1.1", "http/2"}
+)
+
 func dataSourceFastlyTLSConfigurationRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*FastlyClient).conn
 
@@ -3,9 +3,37 @@ package fastly
 import (
 	"testing"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
+func TestDataSourceFastlyTLSConfigurationProtocolFilters(t *testing.T) {
+	s := dataSourceFastlyTLSConfiguration().Schema
+	for _, c := range []struct {
+		key        string
+		value      string
+		expectWarn bool
+	}{
+		{key: "tls_protocols", value: "1.2"},
+		{key: "tls_protocols", value: "1.3"},
+		{key: "tls_protocols", value: "TLSv1.2", expectWarn: true},
+		{key: "http_protocols", value: "http/1.1"},
+		{key: "http_protocols", value: "http/2"},
+		{key: "http_protocols", value: "http/3", expectWarn: true},
+	} {
+		t.Run(c.key+"="+c.value, func(t *testing.T) {
+			warns, errs := diagToWarnsAndErrs(s[c.key].Elem.(*schema.Schema).ValidateDiagFunc(c.value, cty.GetAttrPath(c.key)))
+			if len(errs) > 0 {
+				t.Fatalf("unexpected errors: %v", errs)
+			}
+			if c.expectWarn != (len(warns) > 0) {
+				t.Fatalf("expected a warning %t, got %v", c.expectWarn, warns)
+			}
+		})
+	}
+}
+
 func TestAccFastlyDataSourceTLSConfiguration_basic(t *testing.T) {
 	resourceName := "data.fastly_tls_configuration.subject"
 	resource.ParallelTest(t, resource.TestCase{
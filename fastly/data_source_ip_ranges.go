@@ -43,11 +43,12 @@ func dataSourceFastlyIPRangesRead(_ context.Context, d *schema.ResourceData, met
 		return diag.Errorf("Error listing IP ranges: %s", err)
 	}
 
-	d.SetId(hashcode.Strings(append(ipv4addresses, ipv6addresses...)))
-
+	// Sort before computing the ID, so that it doesn't change when the API returns the same ranges in another order.
 	sort.Strings(ipv4addresses)
 	sort.Strings(ipv6addresses)
 
+	d.SetId(hashcode.Strings(append(append([]string{}, ipv4addresses...), ipv6addresses...)))
+
 	if err := d.Set("cidr_blocks", ipv4addresses); err != nil {
 		return diag.Errorf("Error setting ipv4 ranges: %s", err)
 	}
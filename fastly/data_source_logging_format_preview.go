@@ -0,0 +1,218 @@
+package fastly
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strings"
+
+	"github.com/fastly/terraform-provider-fastly/fastly/hashcode"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// loggingFormatDirectiveVariables maps the Apache-style log format directives to the VCL variable they are read from.
+// Directives missing from the map can't be previewed.
+var loggingFormatDirectiveVariables = map[byte]string{
+	'a': "client.ip",
+	'A': "server.ip",
+	'b': "resp.body_bytes_written",
+	'B': "resp.body_bytes_written",
+	'D': "time.elapsed.usec",
+	'h': "client.ip",
+	'H': "req.proto",
+	'm': "req.method",
+	'q': "req.url.qs",
+	's': "resp.status",
+	't': "time.start",
+	'T': "time.elapsed.sec",
+	'U': "req.url.path",
+	'v': "req.http.host",
+	'V': "req.http.host",
+}
+
+func dataSourceFastlyLoggingFormatPreview() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceFastlyLoggingFormatPreviewRead,
+
+		Schema: map[string]*schema.Schema{
+			"format": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The Apache style log format to preview, as used by the `format` attribute of the logging blocks (format version 2)",
+			},
+			"variables": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The synthetic request to render `format` for: a map of VCL variables, e.g. `req.http.host`, to their value. `%{...}V` expressions that aren't a plain variable can be given a value by using the whole expression as the key",
+			},
+			"rendered": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The log line `format` renders to for the given `variables`",
+			},
+			"unresolved": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The directives and VCL variables in `format` that have no value in `variables`, in order of first use. They are rendered as `(null)` in `%{...}V` expressions and as `-` otherwise",
+			},
+			"valid_json": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether `rendered` is a valid JSON document",
+			},
+		},
+	}
+}
+
+func dataSourceFastlyLoggingFormatPreviewRead(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	format := d.Get("format").(string)
+	if i := invalidLoggingFormatDirective(format); i >= 0 {
+		return diag.Errorf("format has a %q at position %d that doesn't start a log format directive. Use %q for a literal %q", "%", i, "%%", "%")
+	}
+
+	variables := map[string]string{}
+	for k, v := range d.Get("variables").(map[string]interface{}) {
+		variables[k] = v.(string)
+	}
+
+	rendered, unresolved := renderLoggingFormat(format, variables)
+
+	d.SetId(hashcode.Strings([]string{format, rendered}))
+
+	if err := d.Set("rendered", rendered); err != nil {
+		return diag.Errorf("Error setting rendered: %s", err)
+	}
+	if err := d.Set("unresolved", unresolved); err != nil {
+		return diag.Errorf("Error setting unresolved: %s", err)
+	}
+	if err := d.Set("valid_json", json.Valid([]byte(rendered))); err != nil {
+		return diag.Errorf("Error setting valid_json: %s", err)
+	}
+
+	return nil
+}
+
+// renderLoggingFormat renders a log format that has already been checked with invalidLoggingFormatDirective, reading
+// the values from variables. This is a best-effort, client-side preview: status code conditions are assumed to be
+// met, and only the json.escape and cstr_escape functions are evaluated in %{...}V expressions. It also returns the
+// directives and variables that have no value.
+func renderLoggingFormat(format string, variables map[string]string) (string, []string) {
+	var out strings.Builder
+	var unresolved []string
+	seen := map[string]bool{}
+	lookup := func(name string) (string, bool) {
+		v, ok := variables[name]
+		if !ok && (strings.HasPrefix(name, "req.http.") || strings.HasPrefix(name, "resp.http.")) {
+			// HTTP header names are case insensitive.
+			for k, kv := range variables {
+				if strings.EqualFold(k, name) {
+					v, ok = kv, true
+					break
+				}
+			}
+		}
+		if !ok && !seen[name] {
+			seen[name] = true
+			unresolved = append(unresolved, name)
+		}
+		return v, ok
+	}
+
+	for i := 0; i < len(format); i++ {
+		if format[i] != '%' {
+			out.WriteByte(format[i])
+			continue
+		}
+		if format[i+1] == '%' {
+			out.WriteByte('%')
+			i++
+			continue
+		}
+		j := loggingFormatDirectiveEnd(format, i)
+		var arg string
+		if k := strings.IndexByte(format[i:j], '{'); k >= 0 {
+			// The argument runs from after the first brace, as conditions and modifiers don't contain any, to the
+			// closing brace right before the directive.
+			arg = format[i+k+1 : j-1]
+		}
+		directive := format[j]
+		i = j
+
+		switch {
+		case directive == 'V':
+			out.WriteString(renderVCLExpression(arg, lookup))
+		case directive == 'i' && arg != "":
+			out.WriteString(valueOrDash(lookup("req.http." + arg)))
+		case directive == 'o' && arg != "":
+			out.WriteString(valueOrDash(lookup("resp.http." + arg)))
+		case directive == 'l' || directive == 'u':
+			out.WriteByte('-')
+		case directive == 'r':
+			method, _ := lookup("req.method")
+			url, _ := lookup("req.url")
+			proto, _ := lookup("req.proto")
+			out.WriteString(method + " " + url + " " + proto)
+		case directive == 'q':
+			if qs, _ := lookup("req.url.qs"); qs != "" {
+				out.WriteString("?" + qs)
+			}
+		case directive == 't' && arg == "":
+			out.WriteString("[" + valueOrDash(lookup("time.start")) + "]")
+		case arg != "":
+			// Only the i, o and V directives take an argument that can be previewed.
+			out.WriteString(valueOrDash(lookup("%{" + arg + "}" + string(directive))))
+		case directive == 'b':
+			if v, _ := lookup("resp.body_bytes_written"); v != "" && v != "0" {
+				out.WriteString(v)
+			} else {
+				out.WriteByte('-')
+			}
+		default:
+			name, ok := loggingFormatDirectiveVariables[directive]
+			if !ok {
+				name = "%" + string(directive)
+			}
+			out.WriteString(valueOrDash(lookup(name)))
+		}
+	}
+
+	return out.String(), unresolved
+}
+
+// renderVCLExpression renders the VCL expression of a %{...}V directive.
+func renderVCLExpression(expr string, lookup func(string) (string, bool)) string {
+	expr = strings.TrimSpace(expr)
+	for _, fn := range []string{"json.escape", "cstr_escape"} {
+		if strings.HasPrefix(expr, fn+"(") && strings.HasSuffix(expr, ")") {
+			v, ok := lookup(strings.TrimSpace(expr[len(fn)+1 : len(expr)-1]))
+			if !ok {
+				return "(null)"
+			}
+			return escapeVCLString(v)
+		}
+	}
+	if v, ok := lookup(expr); ok {
+		return v
+	}
+	return "(null)"
+}
+
+// escapeVCLString escapes a value the way json.escape and cstr_escape do, as the body of a double quoted string.
+func escapeVCLString(v string) string {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	_ = enc.Encode(v)
+	s := strings.TrimSuffix(buf.String(), "\n")
+	return s[1 : len(s)-1]
+}
+
+func valueOrDash(v string, _ bool) string {
+	if v == "" {
+		return "-"
+	}
+	return v
+}
@@ -0,0 +1,130 @@
+package fastly
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestRenderLoggingFormat(t *testing.T) {
+	variables := map[string]string{
+		"client.ip":               "192.0.2.1",
+		"req.method":              "GET",
+		"req.url":                 "/index.html?q=1",
+		"req.url.path":            "/index.html",
+		"req.url.qs":              "q=1",
+		"req.proto":               "HTTP/1.1",
+		"req.http.host":           "example.com",
+		"req.http.User-Agent":     `curl/7.64.1 "test"`,
+		"resp.status":             "200",
+		"resp.body_bytes_written": "0",
+		"time.start":              "10/Oct/2000:13:55:36 -0700",
+		"time.elapsed.sec":        "1",
+	}
+
+	for _, c := range []struct {
+		name               string
+		format             string
+		expectedRendered   string
+		expectedUnresolved []string
+	}{
+		{
+			name:             "common log format",
+			format:           `%h %l %u %t "%r" %>s %b`,
+			expectedRendered: `192.0.2.1 - - [10/Oct/2000:13:55:36 -0700] "GET /index.html?q=1 HTTP/1.1" 200 -`,
+		},
+		{
+			name:             "path and query string",
+			format:           `%m %U%q %H %T`,
+			expectedRendered: `GET /index.html?q=1 HTTP/1.1 1`,
+		},
+		{
+			name:             "headers and literal percent",
+			format:           `%{Host}i 100%% %{Content-Type}o`,
+			expectedRendered: `example.com 100% -`,
+			expectedUnresolved: []string{
+				"resp.http.Content-Type",
+			},
+		},
+		{
+			name:             "status code conditions and modifiers",
+			format:           `%!200,304{Host}i %200>s %<{req.url.path}V`,
+			expectedRendered: `example.com 200 /index.html`,
+		},
+		{
+			name:             "json with escaped VCL variables",
+			format:           `{"host":"%{json.escape(req.http.host)}V","ua":"%{json.escape(req.http.User-Agent)}V","geo":"%{client.geo.country_code}V"}`,
+			expectedRendered: `{"host":"example.com","ua":"curl/7.64.1 \"test\"","geo":"(null)"}`,
+			expectedUnresolved: []string{
+				"client.geo.country_code",
+			},
+		},
+		{
+			name:             "directives that can't be previewed",
+			format:           `%p %{%Y-%m-%d}t %p`,
+			expectedRendered: `- - -`,
+			expectedUnresolved: []string{
+				"%p",
+				"%{%Y-%m-%d}t",
+			},
+		},
+	} {
+		t.Run(c.name, func(t *testing.T) {
+			rendered, unresolved := renderLoggingFormat(c.format, variables)
+			if rendered != c.expectedRendered {
+				t.Errorf("Error matching:\nexpected: %s\ngot: %s", c.expectedRendered, rendered)
+			}
+			if diff := cmp.Diff(c.expectedUnresolved, unresolved); diff != "" {
+				t.Errorf("Error matching unresolved: %s", diff)
+			}
+		})
+	}
+}
+
+func TestAccFastlyLoggingFormatPreview(t *testing.T) {
+	// NOTE: Using Test instead of ParallelTest because of the "no_auth" option, see TestAccFastlyIPRanges.
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFastlyLoggingFormatPreviewConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.fastly_logging_format_preview.json", "rendered", `{"host":"example.com","status":200}`),
+					resource.TestCheckResourceAttr("data.fastly_logging_format_preview.json", "unresolved.#", "0"),
+					resource.TestCheckResourceAttr("data.fastly_logging_format_preview.json", "valid_json", "true"),
+				),
+			},
+			{
+				Config:      testAccFastlyLoggingFormatPreviewConfig_invalid,
+				ExpectError: regexp.MustCompile("doesn't start a log format directive"),
+			},
+		},
+	})
+}
+
+const testAccFastlyLoggingFormatPreviewConfig = `
+provider "fastly" {
+  no_auth = true
+}
+
+data "fastly_logging_format_preview" "json" {
+  format = "{\"host\":\"%{json.escape(req.http.host)}V\",\"status\":%>s}"
+
+  variables = {
+    "req.http.host" = "example.com"
+    "resp.status"   = "200"
+  }
+}
+`
+
+const testAccFastlyLoggingFormatPreviewConfig_invalid = `
+provider "fastly" {
+  no_auth = true
+}
+
+data "fastly_logging_format_preview" "invalid" {
+  format = "100% %h"
+}
+`
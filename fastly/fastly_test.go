@@ -2,8 +2,11 @@ package fastly
 
 import (
 	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
 	"reflect"
 
+	gofastly "github.com/fastly/go-fastly/v6/fastly"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -32,6 +35,79 @@ func caCert(t *testing.T) string {
 	return readTestFile("./test_fixtures/fastly_test_cacert", t)
 }
 
+// testFastlyClient returns a go-fastly client whose requests are served by handler, for unit testing API calls.
+func testFastlyClient(t *testing.T, handler http.HandlerFunc) *gofastly.Client {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	conn, err := gofastly.NewClientForEndpoint("token", server.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	return conn
+}
+
+// testServiceVCLData returns the resource data of a fastly_service_vcl named "test" with the given blocks, for unit
+// testing validations and warnings. Empty blocks are left out.
+func testServiceVCLData(t *testing.T, blocks map[string][]interface{}) *schema.ResourceData {
+	raw := map[string]interface{}{"name": "test"}
+	for k, v := range blocks {
+		if len(v) > 0 {
+			raw[k] = v
+		}
+	}
+	return schema.TestResourceDataRaw(t, resourceServiceVCL().Schema, raw)
+}
+
+// testExpectError fails the test when err doesn't match expectError.
+func testExpectError(t *testing.T, err error, expectError bool) {
+	t.Helper()
+	if expectError && err == nil {
+		t.Fatal("expected an error, got none")
+	}
+	if !expectError && err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+}
+
+// The test* fixture builders below return service blocks for testServiceVCLData.
+
+func testBackend(name, shield string) map[string]interface{} {
+	return map[string]interface{}{"name": name, "address": name + ".example.com", "shield": shield}
+}
+
+func testDirector(shield string, backends ...interface{}) map[string]interface{} {
+	return map[string]interface{}{"name": "director", "shield": shield, "backends": backends}
+}
+
+func testCondition(name, conditionType string) map[string]interface{} {
+	return map[string]interface{}{"name": name, "type": conditionType, "statement": "req.url ~ \"^/alt/\""}
+}
+
+func testResponseObject(name, requestCondition, cacheCondition string) map[string]interface{} {
+	return map[string]interface{}{
+		"name":              name,
+		"status":            403,
+		"response":          "Forbidden",
+		"request_condition": requestCondition,
+		"cache_condition":   cacheCondition,
+	}
+}
+
+func testHealthcheck(name string) map[string]interface{} {
+	return map[string]interface{}{"name": name, "host": "example.com", "path": "/"}
+}
+
+func testHeader(name, action, source string) map[string]interface{} {
+	return map[string]interface{}{
+		"name":        name,
+		"action":      action,
+		"type":        "request",
+		"destination": "http.x-" + name,
+		"source":      source,
+	}
+}
+
 func readTestFile(filename string, t *testing.T) string {
 	contents, err := ioutil.ReadFile(filename)
 	if err != nil {
@@ -0,0 +1,121 @@
+package fastly
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"sort"
+
+	gofastly "github.com/fastly/go-fastly/v6/fastly"
+	"github.com/mitchellh/mapstructure"
+)
+
+// This file holds a minimal client for the New Relic OTLP logging API, which go-fastly v6 doesn't cover yet. It goes
+// through the go-fastly client so that authentication, retries and error handling match the rest of the provider, and
+// should be replaced by the go-fastly equivalents once the dependency is upgraded.
+
+// newRelicOTLP models a New Relic OTLP logging endpoint as returned by the API.
+type newRelicOTLP struct {
+	Name              string `mapstructure:"name"`
+	Token             string `mapstructure:"token"`
+	URL               string `mapstructure:"url"`
+	Region            string `mapstructure:"region"`
+	Format            string `mapstructure:"format"`
+	FormatVersion     uint   `mapstructure:"format_version"`
+	Placement         string `mapstructure:"placement"`
+	ResponseCondition string `mapstructure:"response_condition"`
+}
+
+type createNewRelicOTLPInput struct {
+	Name              string `url:"name,omitempty"`
+	Token             string `url:"token,omitempty"`
+	URL               string `url:"url,omitempty"`
+	Region            string `url:"region,omitempty"`
+	Format            string `url:"format,omitempty"`
+	FormatVersion     uint   `url:"format_version,omitempty"`
+	Placement         string `url:"placement,omitempty"`
+	ResponseCondition string `url:"response_condition,omitempty"`
+}
+
+type updateNewRelicOTLPInput struct {
+	Token             *string `url:"token,omitempty"`
+	URL               *string `url:"url,omitempty"`
+	Region            *string `url:"region,omitempty"`
+	Format            *string `url:"format,omitempty"`
+	FormatVersion     *uint   `url:"format_version,omitempty"`
+	Placement         *string `url:"placement,omitempty"`
+	ResponseCondition *string `url:"response_condition,omitempty"`
+}
+
+func newRelicOTLPPath(serviceID string, serviceVersion int) string {
+	return fmt.Sprintf("/service/%s/version/%d/logging/newrelicotlp", serviceID, serviceVersion)
+}
+
+func listNewRelicOTLP(conn *gofastly.Client, serviceID string, serviceVersion int) ([]*newRelicOTLP, error) {
+	resp, err := conn.Get(newRelicOTLPPath(serviceID, serviceVersion), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var parsed interface{}
+	if err := json.NewDecoder(resp.Body).Decode(&parsed); err != nil {
+		return nil, err
+	}
+
+	var n []*newRelicOTLP
+	if err := decodeNewRelicOTLP(parsed, &n); err != nil {
+		return nil, err
+	}
+	sort.SliceStable(n, func(i, j int) bool { return n[i].Name < n[j].Name })
+	return n, nil
+}
+
+// decodeNewRelicOTLP decodes an API response the way go-fastly does, which
+// accepts numeric attributes such as format_version being sent as strings.
+func decodeNewRelicOTLP(in, out interface{}) error {
+	decoder, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
+		WeaklyTypedInput: true,
+		Result:           out,
+	})
+	if err != nil {
+		return err
+	}
+	return decoder.Decode(in)
+}
+
+func createNewRelicOTLP(conn *gofastly.Client, serviceID string, serviceVersion int, i *createNewRelicOTLPInput) error {
+	resp, err := conn.PostForm(newRelicOTLPPath(serviceID, serviceVersion), i, nil)
+	if err != nil {
+		return err
+	}
+	return resp.Body.Close()
+}
+
+func updateNewRelicOTLP(conn *gofastly.Client, serviceID string, serviceVersion int, name string, i *updateNewRelicOTLPInput) error {
+	path := fmt.Sprintf("%s/%s", newRelicOTLPPath(serviceID, serviceVersion), url.PathEscape(name))
+	resp, err := conn.PutForm(path, i, nil)
+	if err != nil {
+		return err
+	}
+	return resp.Body.Close()
+}
+
+func deleteNewRelicOTLP(conn *gofastly.Client, serviceID string, serviceVersion int, name string) error {
+	path := fmt.Sprintf("%s/%s", newRelicOTLPPath(serviceID, serviceVersion), url.PathEscape(name))
+	resp, err := conn.Delete(path, nil)
+
+	errRes, ok := err.(*gofastly.HTTPError)
+	if !ok {
+		if err != nil {
+			return err
+		}
+		return resp.Body.Close()
+	}
+	// 404 response codes don't result in an error propagating because a 404 could
+	// indicate that a resource was deleted elsewhere.
+	if !errRes.IsNotFound() {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,167 @@
+package fastly
+
+import (
+	"net/http"
+	"testing"
+
+	gofastly "github.com/fastly/go-fastly/v6/fastly"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestListNewRelicOTLP(t *testing.T) {
+	conn := testFastlyClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/service/123/version/2/logging/newrelicotlp" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`[
+			{"name": "second", "token": "token", "region": "EU", "format_version": "2", "created_at": "2021-01-01T00:00:00Z"},
+			{"name": "first", "token": "token", "region": "US", "url": "https://otlp.nr-data.net", "format_version": "1"}
+		]`))
+	})
+
+	out, err := listNewRelicOTLP(conn, "123", 2)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// Endpoints are sorted by name.
+	expected := []*newRelicOTLP{
+		{Name: "first", Token: "token", Region: "US", URL: "https://otlp.nr-data.net", FormatVersion: 1},
+		{Name: "second", Token: "token", Region: "EU", FormatVersion: 2},
+	}
+	if diff := cmp.Diff(expected, out); diff != "" {
+		t.Fatalf("Error matching: %s", diff)
+	}
+}
+
+func TestListNewRelicOTLPError(t *testing.T) {
+	conn := testFastlyClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		_, _ = w.Write([]byte(`{"msg": "Provided credentials are missing or invalid"}`))
+	})
+
+	if _, err := listNewRelicOTLP(conn, "123", 2); err == nil {
+		t.Fatal("expected an error, got none")
+	}
+}
+
+func TestCreateNewRelicOTLP(t *testing.T) {
+	conn := testFastlyClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/service/123/version/2/logging/newrelicotlp" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		if err := r.ParseForm(); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		for k, v := range map[string]string{"name": "newrelicotlp-endpoint", "token": "token", "region": "EU", "format_version": "2"} {
+			if got := r.PostForm.Get(k); got != v {
+				t.Errorf("expected %s to be %q, got %q", k, v, got)
+			}
+		}
+		// Unset attributes are left for the API to default.
+		if _, ok := r.PostForm["url"]; ok {
+			t.Errorf("expected url to be omitted, got %q", r.PostForm.Get("url"))
+		}
+		_, _ = w.Write([]byte(`{}`))
+	})
+
+	err := createNewRelicOTLP(conn, "123", 2, &createNewRelicOTLPInput{
+		Name:          "newrelicotlp-endpoint",
+		Token:         "token",
+		Region:        "EU",
+		FormatVersion: 2,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+}
+
+func TestUpdateNewRelicOTLP(t *testing.T) {
+	conn := testFastlyClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut || r.URL.EscapedPath() != "/service/123/version/2/logging/newrelicotlp/my%20endpoint" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.EscapedPath())
+		}
+		if err := r.ParseForm(); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		// Only the modified attributes are sent, and an empty string clears one.
+		expected := map[string][]string{"region": {"US"}, "url": {""}}
+		if diff := cmp.Diff(expected, map[string][]string(r.PostForm)); diff != "" {
+			t.Errorf("Error matching: %s", diff)
+		}
+		_, _ = w.Write([]byte(`{}`))
+	})
+
+	err := updateNewRelicOTLP(conn, "123", 2, "my endpoint", &updateNewRelicOTLPInput{
+		Region: gofastly.String("US"),
+		URL:    gofastly.String(""),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+}
+
+func TestDeleteNewRelicOTLP(t *testing.T) {
+	for _, testcase := range []struct {
+		name        string
+		status      int
+		expectError bool
+	}{
+		{"deleted", http.StatusOK, false},
+		// A 404 means the endpoint was already deleted elsewhere.
+		{"not found", http.StatusNotFound, false},
+		{"server error", http.StatusInternalServerError, true},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			conn := testFastlyClient(t, func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodDelete || r.URL.Path != "/service/123/version/2/logging/newrelicotlp/newrelicotlp-endpoint" {
+					t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+				}
+				w.WriteHeader(testcase.status)
+				_, _ = w.Write([]byte(`{}`))
+			})
+
+			err := deleteNewRelicOTLP(conn, "123", 2, "newrelicotlp-endpoint")
+			if testcase.expectError && err == nil {
+				t.Error("expected an error, got none")
+			}
+			if !testcase.expectError && err != nil {
+				t.Errorf("unexpected error: %s", err)
+			}
+		})
+	}
+}
+
+func TestResourceFastlyDecodeNewRelicOTLP(t *testing.T) {
+	// The API returns numeric attributes as strings.
+	in := []interface{}{
+		map[string]interface{}{
+			"name":           "newrelicotlp-endpoint",
+			"token":          "token",
+			"region":         "EU",
+			"format":         "%h",
+			"format_version": "2",
+			"placement":      "",
+			"created_at":     "2021-01-01T00:00:00Z",
+		},
+	}
+
+	var out []*newRelicOTLP
+	if err := decodeNewRelicOTLP(in, &out); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := []*newRelicOTLP{
+		{
+			Name:          "newrelicotlp-endpoint",
+			Token:         "token",
+			Region:        "EU",
+			Format:        "%h",
+			FormatVersion: 2,
+		},
+	}
+	if diff := cmp.Diff(expected, out); diff != "" {
+		t.Fatalf("Error matching: %s", diff)
+	}
+}
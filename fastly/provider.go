@@ -39,10 +39,17 @@ func Provider() *schema.Provider {
 				Default:     false,
 				Description: "Set this to `true` to disable HTTP/1.x fallback mechanism that the underlying Go library will attempt upon connection to `api.fastly.com:443` by default. This may slightly improve the provider's performance and reduce unnecessary TLS handshakes. Default: `false`",
 			},
+			"warn_logging_format_version_1": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Set this to `true` to emit a warning for every logging endpoint still using `format_version = 1`, to help with migrating to [version 2 log formats](https://docs.fastly.com/en/guides/custom-log-formats#version-2-log-format). Default: `false`",
+			},
 		},
 		DataSourcesMap: map[string]*schema.Resource{
 			"fastly_datacenters":                  dataSourceFastlyDatacenters(),
 			"fastly_ip_ranges":                    dataSourceFastlyIPRanges(),
+			"fastly_logging_format_preview":       dataSourceFastlyLoggingFormatPreview(),
 			"fastly_tls_activation":               dataSourceFastlyTLSActivation(),
 			"fastly_tls_activation_ids":           dataSourceFastlyTLSActivationIds(),
 			"fastly_tls_certificate":              dataSourceFastlyTLSCertificate(),
@@ -62,6 +69,8 @@ func Provider() *schema.Provider {
 			"fastly_service_vcl":                     resourceServiceVCL(),
 			"fastly_service_compute":                 resourceServiceCompute(),
 			"fastly_service_acl_entries":             resourceServiceAclEntries(),
+			"fastly_service_activation":              resourceServiceActivation(),
+			"fastly_service_authorization":           resourceServiceAuthorization(),
 			"fastly_service_dictionary_items":        resourceServiceDictionaryItems(),
 			"fastly_service_dynamic_snippet_content": resourceServiceDynamicSnippetContent(),
 			"fastly_service_waf_configuration":       resourceServiceWAFConfiguration(),
@@ -82,6 +91,8 @@ func Provider() *schema.Provider {
 			NoAuth:     d.Get("no_auth").(bool),
 			ForceHttp2: d.Get("force_http2").(bool),
 			UserAgent:  provider.UserAgent(TerraformProviderProductUserAgent, version.ProviderVersion),
+
+			WarnLoggingFormatVersion1: d.Get("warn_logging_format_version_1").(bool),
 		}
 		return config.Client()
 	}
@@ -3,10 +3,13 @@ package fastly
 import (
 	"context"
 	"fmt"
+	"net"
+	"sort"
 	"strconv"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 
 	gofastly "github.com/fastly/go-fastly/v6/fastly"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -21,6 +24,9 @@ func resourceServiceAclEntries() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceServiceACLEntriesImport,
 		},
+		CustomizeDiff: customdiff.All(
+			customdiff.ValidateValue("entry", resourceServiceACLEntriesValidateEntries),
+		),
 
 		Schema: map[string]*schema.Schema{
 			"service_id": {
@@ -65,7 +71,7 @@ func resourceServiceAclEntries() *schema.Resource {
 						"subnet": {
 							Type:        schema.TypeString,
 							Optional:    true,
-							Description: "An optional subnet mask applied to the IP address",
+							Description: "An optional subnet mask applied to the IP address, as a prefix length. When unset, the entry matches the IP address only. Note that `0` matches every address",
 						},
 						"negated": {
 							Type:        schema.TypeBool,
@@ -153,56 +159,67 @@ func resourceServiceAclEntriesUpdate(ctx context.Context, d *schema.ResourceData
 			ne = new(schema.Set)
 		}
 
-		oldSet := oe.(*schema.Set)
-		newSet := ne.(*schema.Set)
-
-		setDiff := NewSetDiff(func(resource interface{}) (interface{}, error) {
-			t, ok := resource.(map[string]interface{})
-			if !ok {
-				return nil, fmt.Errorf("resource failed to be type asserted: %+v", resource)
-			}
-			return t["id"], nil
-		})
-
-		diffResult, err := setDiff.Diff(oldSet, newSet)
+		var err error
+		batchACLEntries, err = aclEntriesBatchOperations(oe.(*schema.Set), ne.(*schema.Set))
 		if err != nil {
 			return diag.FromErr(err)
 		}
+	}
 
-		// DELETE removed resources
-		for _, resource := range diffResult.Deleted {
-			resource := resource.(map[string]interface{})
+	// Process the batch operations
+	err := executeBatchACLOperations(conn, serviceID, aclID, batchACLEntries)
+	if err != nil {
+		return diag.Errorf("Error updating ACL entries: service %s, ACL %s, %s", serviceID, aclID, err)
+	}
 
-			batchACLEntries = append(batchACLEntries, &gofastly.BatchACLEntry{
-				Operation: gofastly.DeleteBatchOperation,
-				ID:        gofastly.String(resource["id"].(string)),
-			})
-		}
+	return resourceServiceAclEntriesRead(ctx, d, meta)
+}
 
-		// CREATE new resources
-		for _, resource := range diffResult.Added {
-			resource := resource.(map[string]interface{})
+// aclEntriesBatchOperations returns the batch operations that turn the old entries into the new ones. Entries are
+// matched by their ID, so an entry that keeps its ID is updated in place rather than deleted and created again.
+func aclEntriesBatchOperations(oldSet, newSet *schema.Set) ([]*gofastly.BatchACLEntry, error) {
+	var batchACLEntries = []*gofastly.BatchACLEntry{}
 
-			entry := buildBatchACLEntry(resource, gofastly.CreateBatchOperation)
-			batchACLEntries = append(batchACLEntries, entry)
+	setDiff := NewSetDiff(func(resource interface{}) (interface{}, error) {
+		t, ok := resource.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("resource failed to be type asserted: %+v", resource)
 		}
+		return t["id"], nil
+	})
 
-		// UPDATE modified resources
-		for _, resource := range diffResult.Modified {
-			resource := resource.(map[string]interface{})
+	diffResult, err := setDiff.Diff(oldSet, newSet)
+	if err != nil {
+		return nil, err
+	}
 
-			entry := buildBatchACLEntry(resource, gofastly.UpdateBatchOperation)
-			batchACLEntries = append(batchACLEntries, entry)
-		}
+	// DELETE removed resources
+	for _, resource := range diffResult.Deleted {
+		resource := resource.(map[string]interface{})
+
+		batchACLEntries = append(batchACLEntries, &gofastly.BatchACLEntry{
+			Operation: gofastly.DeleteBatchOperation,
+			ID:        gofastly.String(resource["id"].(string)),
+		})
 	}
 
-	// Process the batch operations
-	err := executeBatchACLOperations(conn, serviceID, aclID, batchACLEntries)
-	if err != nil {
-		return diag.Errorf("Error updating ACL entries: service %s, ACL %s, %s", serviceID, aclID, err)
+	// CREATE new resources
+	for _, resource := range diffResult.Added {
+		resource := resource.(map[string]interface{})
+
+		entry := buildBatchACLEntry(resource, gofastly.CreateBatchOperation)
+		batchACLEntries = append(batchACLEntries, entry)
 	}
 
-	return resourceServiceAclEntriesRead(ctx, d, meta)
+	// UPDATE modified resources
+	for _, resource := range diffResult.Modified {
+		resource := resource.(map[string]interface{})
+
+		entry := buildBatchACLEntry(resource, gofastly.UpdateBatchOperation)
+		batchACLEntries = append(batchACLEntries, entry)
+	}
+
+	return batchACLEntries, nil
 }
 
 func resourceServiceAclEntriesDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -329,6 +346,52 @@ func buildBatchACLEntry(v map[string]interface{}, op gofastly.BatchOperation) *g
 	return entry
 }
 
+// resourceServiceACLEntriesValidateEntries returns an error listing every entry whose ip isn't an IPv4 or IPv6
+// address, or whose subnet isn't a prefix length for the address family. The batch API rejects the whole batch for a
+// single bad entry without saying which one, which is hard to track down in a large ACL.
+func resourceServiceACLEntriesValidateEntries(_ context.Context, v, _ interface{}) error {
+	var invalid []string
+	for _, elem := range v.(*schema.Set).List() {
+		entry := elem.(map[string]interface{})
+		ip, _ := entry["ip"].(string)
+		subnet, _ := entry["subnet"].(string)
+		if ip == "" {
+			// The ip isn't known until apply.
+			continue
+		}
+		if err := validateACLEntry(ip, subnet); err != nil {
+			invalid = append(invalid, err.Error())
+		}
+	}
+	if len(invalid) == 0 {
+		return nil
+	}
+	sort.Strings(invalid)
+	return fmt.Errorf("invalid ACL entries:\n%s", strings.Join(invalid, "\n"))
+}
+
+func validateACLEntry(ip, subnet string) error {
+	if strings.Contains(ip, "/") {
+		return fmt.Errorf("entry %q: ip must be an address without a prefix length, set the prefix length as subnet", ip)
+	}
+	if net.ParseIP(ip) == nil {
+		return fmt.Errorf("entry %q: ip must be an IPv4 or IPv6 address", ip)
+	}
+	if subnet == "" {
+		return nil
+	}
+
+	bits := 32
+	if strings.Contains(ip, ":") {
+		bits = 128
+	}
+	prefix, err := strconv.Atoi(subnet)
+	if err != nil || prefix < 0 || prefix > bits {
+		return fmt.Errorf("entry %q: subnet %q must be a prefix length between 0 and %d", ip, subnet, bits)
+	}
+	return nil
+}
+
 func convertSubnetToInt(s string) int {
 	subnet, _ := strconv.Atoi(s)
 	return subnet
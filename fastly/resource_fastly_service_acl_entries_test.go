@@ -10,6 +10,7 @@ import (
 	gofastly "github.com/fastly/go-fastly/v6/fastly"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
@@ -62,6 +63,147 @@ func TestResourceFastlyFlattenAclEntries(t *testing.T) {
 	}
 }
 
+func TestResourceFastlyACLEntriesRoundTrip(t *testing.T) {
+	remote := []*gofastly.ACLEntry{
+		{
+			ID:      "entry-1",
+			IP:      "10.0.0.0",
+			Subnet:  gofastly.Int(8),
+			Negated: true,
+			Comment: `Deny "10/8" & <internal> ranges \ naïve ✓`,
+		},
+		{
+			ID:      "entry-2",
+			IP:      "0.0.0.0",
+			Subnet:  gofastly.Int(0),
+			Negated: false,
+			Comment: "${not_interpolated}",
+		},
+		{
+			ID:      "entry-3",
+			IP:      "2001:db8::1",
+			Negated: true,
+		},
+	}
+
+	flattened := flattenAclEntries(remote)
+	if len(flattened) != len(remote) {
+		t.Fatalf("expected %d entries, got %d", len(remote), len(flattened))
+	}
+
+	for i, e := range remote {
+		m := flattened[i]
+		if m["negated"] != e.Negated {
+			t.Errorf("entry %s: expected negated %t, got %#v", e.ID, e.Negated, m["negated"])
+		}
+
+		// Fill in the zero values Terraform would hold for attributes pruned during flattening.
+		for _, k := range []string{"subnet", "comment"} {
+			if _, ok := m[k]; !ok {
+				m[k] = ""
+			}
+		}
+
+		entry := buildBatchACLEntry(m, gofastly.UpdateBatchOperation)
+		if *entry.IP != e.IP {
+			t.Errorf("entry %s: expected ip %q, got %q", e.ID, e.IP, *entry.IP)
+		}
+		if bool(*entry.Negated) != e.Negated {
+			t.Errorf("entry %s: expected negated %t, got %t", e.ID, e.Negated, bool(*entry.Negated))
+		}
+		if *entry.Comment != e.Comment {
+			t.Errorf("entry %s: expected comment %q, got %q", e.ID, e.Comment, *entry.Comment)
+		}
+		if !reflect.DeepEqual(entry.Subnet, e.Subnet) {
+			t.Errorf("entry %s: expected subnet %#v, got %#v", e.ID, e.Subnet, entry.Subnet)
+		}
+	}
+}
+
+func TestResourceFastlyACLEntriesBatchOperations(t *testing.T) {
+	entrySchema := resourceServiceAclEntries().Schema["entry"].Elem.(*schema.Resource)
+	newEntrySet := func(entries ...map[string]interface{}) *schema.Set {
+		s := schema.NewSet(schema.HashResource(entrySchema), nil)
+		for _, e := range entries {
+			s.Add(e)
+		}
+		return s
+	}
+
+	unchanged := map[string]interface{}{"id": "entry-1", "ip": "127.0.0.1", "subnet": "", "negated": false, "comment": ""}
+	removed := map[string]interface{}{"id": "entry-2", "ip": "10.0.0.0", "subnet": "8", "negated": false, "comment": ""}
+	negated := map[string]interface{}{"id": "entry-3", "ip": "192.168.0.0", "subnet": "16", "negated": false, "comment": ""}
+	negatedAfter := map[string]interface{}{"id": "entry-3", "ip": "192.168.0.0", "subnet": "16", "negated": true, "comment": ""}
+	// A subnet of "0" matches every address, unlike an unset subnet which matches the single host.
+	added := map[string]interface{}{"id": "", "ip": "0.0.0.0", "subnet": "0", "negated": false, "comment": "everything"}
+
+	out, err := aclEntriesBatchOperations(newEntrySet(unchanged, removed, negated), newEntrySet(unchanged, negatedAfter, added))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := []*gofastly.BatchACLEntry{
+		{Operation: gofastly.DeleteBatchOperation, ID: gofastly.String("entry-2")},
+		{
+			Operation: gofastly.CreateBatchOperation,
+			ID:        gofastly.String(""),
+			IP:        gofastly.String("0.0.0.0"),
+			Subnet:    gofastly.Int(0),
+			Negated:   gofastly.CBool(false),
+			Comment:   gofastly.String("everything"),
+		},
+		{
+			Operation: gofastly.UpdateBatchOperation,
+			ID:        gofastly.String("entry-3"),
+			IP:        gofastly.String("192.168.0.0"),
+			Subnet:    gofastly.Int(16),
+			Negated:   gofastly.CBool(true),
+			Comment:   gofastly.String(""),
+		},
+	}
+	if !reflect.DeepEqual(out, expected) {
+		t.Fatalf("Error matching:\nexpected: %#v\ngot: %#v", expected, out)
+	}
+
+	// An unset subnet isn't sent, so the entry matches a single host.
+	entry := buildBatchACLEntry(unchanged, gofastly.CreateBatchOperation)
+	if entry.Subnet != nil {
+		t.Fatalf("expected no subnet for an entry without one, got %d", *entry.Subnet)
+	}
+}
+
+func TestResourceFastlyValidateACLEntry(t *testing.T) {
+	for name, c := range map[string]struct {
+		ip          string
+		subnet      string
+		expectError bool
+	}{
+		"ipv4 address":        {ip: "127.0.0.1"},
+		"ipv4 subnet":         {ip: "10.0.0.0", subnet: "8"},
+		"ipv4 zero subnet":    {ip: "0.0.0.0", subnet: "0"},
+		"ipv4 host subnet":    {ip: "192.168.0.1", subnet: "32"},
+		"ipv6 subnet":         {ip: "2001:db8::", subnet: "48"},
+		"ipv6 host subnet":    {ip: "2001:db8::1", subnet: "128"},
+		"invalid address":     {ip: "256.0.0.1", expectError: true},
+		"hostname":            {ip: "example.com", expectError: true},
+		"cidr in ip":          {ip: "10.0.0.0/8", expectError: true},
+		"ipv4 subnet too big": {ip: "10.0.0.0", subnet: "33", expectError: true},
+		"ipv6 subnet too big": {ip: "2001:db8::", subnet: "129", expectError: true},
+		"negative subnet":     {ip: "10.0.0.0", subnet: "-1", expectError: true},
+		"non-numeric subnet":  {ip: "10.0.0.0", subnet: "255.0.0.0", expectError: true},
+	} {
+		t.Run(name, func(t *testing.T) {
+			err := validateACLEntry(c.ip, c.subnet)
+			if c.expectError && err == nil {
+				t.Fatal("expected an error, got none")
+			}
+			if !c.expectError && err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+		})
+	}
+}
+
 func TestAccFastlyServiceAclEntries_create(t *testing.T) {
 	var service gofastly.ServiceDetail
 	serviceName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
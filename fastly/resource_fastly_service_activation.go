@@ -0,0 +1,142 @@
+package fastly
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	gofastly "github.com/fastly/go-fastly/v6/fastly"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceServiceActivation() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceServiceActivationCreate,
+		ReadContext:   resourceServiceActivationRead,
+		UpdateContext: resourceServiceActivationUpdate,
+		DeleteContext: resourceServiceActivationDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"service_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the service to activate a version of",
+			},
+
+			"version": {
+				Type:             schema.TypeInt,
+				Required:         true,
+				Description:      "The number of the version to activate. The version must exist and, when this resource is created, must not already be active. Changing this activates the new version, e.g. to roll back to a previous version",
+				ValidateDiagFunc: validateServiceVersion(),
+			},
+		},
+	}
+}
+
+func resourceServiceActivationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*FastlyClient).conn
+
+	serviceID := d.Get("service_id").(string)
+	if err := activateServiceVersion(conn, serviceID, d.Get("version").(int)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(serviceID)
+
+	return resourceServiceActivationRead(ctx, d, meta)
+}
+
+func resourceServiceActivationRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*FastlyClient).conn
+
+	s, err := conn.GetServiceDetails(&gofastly.GetServiceInput{
+		ID: d.Id(),
+	})
+	if err != nil {
+		if e, ok := err.(*gofastly.HTTPError); ok && e.IsNotFound() {
+			log.Printf("[WARN] %s for ID (%s)", fastlyNoServiceFoundErr, d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+	if s.DeletedAt != nil {
+		log.Printf("[WARN] Service ID (%s) has been deleted", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	err = d.Set("service_id", s.ID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	// If another version has been activated outside of this resource, e.g. from the UI, this produces a diff that
+	// activates the configured version again.
+	err = d.Set("version", s.ActiveVersion.Number)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceServiceActivationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*FastlyClient).conn
+
+	if d.HasChange("version") {
+		if err := activateServiceVersion(conn, d.Id(), d.Get("version").(int)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceServiceActivationRead(ctx, d, meta)
+}
+
+func resourceServiceActivationDelete(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	// Deactivating the version would take the service offline, so the version stays active and the resource is only
+	// removed from the state.
+	log.Printf("[INFO] Removing the activation of service (%s) from the state. Version (%v) remains active", d.Id(), d.Get("version"))
+	return nil
+}
+
+// activateServiceVersion activates the given version of a service, after checking that the version exists and isn't
+// already active.
+func activateServiceVersion(conn *gofastly.Client, serviceID string, version int) error {
+	v, err := conn.GetVersion(&gofastly.GetVersionInput{
+		ServiceID:      serviceID,
+		ServiceVersion: version,
+	})
+	if err != nil {
+		if e, ok := err.(*gofastly.HTTPError); ok && e.IsNotFound() {
+			return fmt.Errorf("Version (%d) of service (%s) does not exist", version, serviceID)
+		}
+		return err
+	}
+	if err := checkServiceVersionActivatable(serviceID, v); err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Activating Fastly Service (%s), Version (%v)", serviceID, version)
+	_, err = conn.ActivateVersion(&gofastly.ActivateVersionInput{
+		ServiceID:      serviceID,
+		ServiceVersion: version,
+	})
+	if err != nil {
+		return fmt.Errorf("[ERR] Error activating version (%d): %s", version, err)
+	}
+
+	return nil
+}
+
+// checkServiceVersionActivatable returns an error if the given version is already active.
+func checkServiceVersionActivatable(serviceID string, v *gofastly.Version) error {
+	if v.Active {
+		return fmt.Errorf("Version (%d) of service (%s) is already active. Import the activation instead of creating it", v.Number, serviceID)
+	}
+	return nil
+}
@@ -0,0 +1,96 @@
+package fastly
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	gofastly "github.com/fastly/go-fastly/v6/fastly"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestResourceFastlyCheckServiceVersionActivatable(t *testing.T) {
+	if err := checkServiceVersionActivatable("123", &gofastly.Version{Number: 1}); err != nil {
+		t.Fatalf("unexpected error for an inactive version: %s", err)
+	}
+	if err := checkServiceVersionActivatable("123", &gofastly.Version{Number: 2, Active: true}); err == nil {
+		t.Fatalf("expected an error for an active version")
+	}
+}
+
+func TestAccFastlyServiceActivation_rollback(t *testing.T) {
+	var service gofastly.ServiceDetail
+	name := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	domain := fmt.Sprintf("fastly-test.tf-%s.com", acctest.RandString(10))
+	backendName := fmt.Sprintf("%s.aws.amazon.com", acctest.RandString(3))
+	backendName2 := fmt.Sprintf("%s.aws.amazon.com", acctest.RandString(3))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckServiceVCLDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceVCLConfig_backend(name, domain, backendName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceVCLExists("fastly_service_vcl.foo", &service),
+					resource.TestCheckResourceAttr("fastly_service_vcl.foo", "active_version", "1"),
+				),
+			},
+			{
+				Config: testAccServiceVCLConfig_backend(name, domain, backendName2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceVCLExists("fastly_service_vcl.foo", &service),
+					resource.TestCheckResourceAttr("fastly_service_vcl.foo", "active_version", "2"),
+				),
+			},
+			{
+				Config:      testAccServiceActivationConfig(name, domain, backendName2, 99),
+				ExpectError: regexp.MustCompile("Version \\(99\\) of service .* does not exist"),
+			},
+			{
+				Config:      testAccServiceActivationConfig(name, domain, backendName2, 2),
+				ExpectError: regexp.MustCompile("Version \\(2\\) of service .* is already active"),
+			},
+			// roll back to the first version
+			{
+				Config: testAccServiceActivationConfig(name, domain, backendName2, 1),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("fastly_service_activation.foo", "version", "1"),
+					testAccCheckFastlyServiceActiveVersion(&service, 1),
+				),
+				// The service resource plans to apply its configuration on top of the rolled back version.
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckFastlyServiceActiveVersion(service *gofastly.ServiceDetail, version int) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		conn := testAccProvider.Meta().(*FastlyClient).conn
+		s, err := conn.GetServiceDetails(&gofastly.GetServiceInput{
+			ID: service.ID,
+		})
+		if err != nil {
+			return err
+		}
+
+		if s.ActiveVersion.Number != version {
+			return fmt.Errorf("Bad active version, expected (%d), got (%d)", version, s.ActiveVersion.Number)
+		}
+		return nil
+	}
+}
+
+func testAccServiceActivationConfig(name, domain, backend string, version int) string {
+	return fmt.Sprintf(`
+%s
+
+resource "fastly_service_activation" "foo" {
+  service_id = fastly_service_vcl.foo.id
+  version    = %d
+}`, testAccServiceVCLConfig_backend(name, domain, backend), version)
+}
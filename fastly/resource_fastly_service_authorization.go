@@ -0,0 +1,196 @@
+package fastly
+
+import (
+	"context"
+	"fmt"
+
+	gofastly "github.com/fastly/go-fastly/v6/fastly"
+	"github.com/google/jsonapi"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// serviceAuthorization models the service-authorizations API, which is not yet covered by go-fastly.
+type serviceAuthorization struct {
+	ID         string                       `jsonapi:"primary,service_authorization"`
+	Permission string                       `jsonapi:"attr,permission,omitempty"`
+	Service    *serviceAuthorizationService `jsonapi:"relation,service,omitempty"`
+	User       *serviceAuthorizationUser    `jsonapi:"relation,user,omitempty"`
+}
+
+type serviceAuthorizationService struct {
+	ID string `jsonapi:"primary,service"`
+}
+
+type serviceAuthorizationUser struct {
+	ID string `jsonapi:"primary,user"`
+}
+
+func resourceServiceAuthorization() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceServiceAuthorizationCreate,
+		ReadContext:   resourceServiceAuthorizationRead,
+		UpdateContext: resourceServiceAuthorizationUpdate,
+		DeleteContext: resourceServiceAuthorizationDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"service_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the service to grant access to",
+			},
+
+			"user_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the user being granted access to the service",
+			},
+
+			"permission": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "full",
+				Description:      "The permission granted to the user. Can be `full` (the default), `read_only`, `purge_select` or `purge_all`. For detailed information on each permission, see [Fastly's Documentation on User permissions](https://docs.fastly.com/en/guides/configuring-user-roles-and-permissions#service-level-access)",
+				ValidateDiagFunc: validateServiceAuthorizationPermission(),
+			},
+		},
+	}
+}
+
+func resourceServiceAuthorizationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*FastlyClient).conn
+
+	sa, err := createServiceAuthorization(conn, &serviceAuthorization{
+		Permission: d.Get("permission").(string),
+		Service:    &serviceAuthorizationService{ID: d.Get("service_id").(string)},
+		User:       &serviceAuthorizationUser{ID: d.Get("user_id").(string)},
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(sa.ID)
+
+	return resourceServiceAuthorizationRead(ctx, d, meta)
+}
+
+func resourceServiceAuthorizationRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*FastlyClient).conn
+
+	sa, err := getServiceAuthorization(conn, d.Id())
+	if err != nil {
+		if e, ok := err.(*gofastly.HTTPError); ok && e.IsNotFound() {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	err = d.Set("permission", sa.Permission)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if sa.Service != nil {
+		err = d.Set("service_id", sa.Service.ID)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if sa.User != nil {
+		err = d.Set("user_id", sa.User.ID)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
+
+func resourceServiceAuthorizationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*FastlyClient).conn
+
+	if d.HasChange("permission") {
+		_, err := updateServiceAuthorization(conn, &serviceAuthorization{
+			ID:         d.Id(),
+			Permission: d.Get("permission").(string),
+		})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceServiceAuthorizationRead(ctx, d, meta)
+}
+
+func resourceServiceAuthorizationDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*FastlyClient).conn
+
+	err := deleteServiceAuthorization(conn, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func createServiceAuthorization(conn *gofastly.Client, i *serviceAuthorization) (*serviceAuthorization, error) {
+	resp, err := conn.PostJSONAPI("/service-authorizations", i, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var sa serviceAuthorization
+	if err := jsonapi.UnmarshalPayload(resp.Body, &sa); err != nil {
+		return nil, err
+	}
+	return &sa, nil
+}
+
+func getServiceAuthorization(conn *gofastly.Client, id string) (*serviceAuthorization, error) {
+	resp, err := conn.Get(fmt.Sprintf("/service-authorizations/%s", id), &gofastly.RequestOptions{
+		Headers: map[string]string{"Accept": jsonapi.MediaType},
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var sa serviceAuthorization
+	if err := jsonapi.UnmarshalPayload(resp.Body, &sa); err != nil {
+		return nil, err
+	}
+	return &sa, nil
+}
+
+func updateServiceAuthorization(conn *gofastly.Client, i *serviceAuthorization) (*serviceAuthorization, error) {
+	resp, err := conn.PatchJSONAPI(fmt.Sprintf("/service-authorizations/%s", i.ID), i, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var sa serviceAuthorization
+	if err := jsonapi.UnmarshalPayload(resp.Body, &sa); err != nil {
+		return nil, err
+	}
+	return &sa, nil
+}
+
+func deleteServiceAuthorization(conn *gofastly.Client, id string) error {
+	resp, err := conn.Delete(fmt.Sprintf("/service-authorizations/%s", id), nil)
+	if err != nil {
+		if e, ok := err.(*gofastly.HTTPError); ok && e.IsNotFound() {
+			return nil
+		}
+		return err
+	}
+	defer resp.Body.Close()
+
+	return nil
+}
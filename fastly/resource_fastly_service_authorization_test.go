@@ -0,0 +1,119 @@
+package fastly
+
+import (
+	"fmt"
+	"testing"
+
+	gofastly "github.com/fastly/go-fastly/v6/fastly"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccFastlyServiceAuthorization_basic(t *testing.T) {
+	serviceName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	domainName := fmt.Sprintf("fastly-test.tf-%s.com", acctest.RandString(10))
+	login := fmt.Sprintf("tf-test-%s@example.com", acctest.RandString(10))
+	userName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckServiceAuthorizationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceAuthorizationConfig(serviceName, domainName, login, userName, "read_only"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceAuthorizationExists("fastly_service_authorization.foo"),
+					resource.TestCheckResourceAttr(
+						"fastly_service_authorization.foo", "permission", "read_only"),
+					resource.TestCheckResourceAttrPair(
+						"fastly_service_authorization.foo", "service_id", "fastly_service_vcl.foo", "id"),
+					resource.TestCheckResourceAttrPair(
+						"fastly_service_authorization.foo", "user_id", "fastly_user.foo", "id"),
+				),
+			},
+
+			{
+				Config: testAccServiceAuthorizationConfig(serviceName, domainName, login, userName, "purge_all"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceAuthorizationExists("fastly_service_authorization.foo"),
+					resource.TestCheckResourceAttr(
+						"fastly_service_authorization.foo", "permission", "purge_all"),
+				),
+			},
+
+			{
+				ResourceName:      "fastly_service_authorization.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckServiceAuthorizationExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Service Authorization ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*FastlyClient).conn
+		_, err := getServiceAuthorization(conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccCheckServiceAuthorizationDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "fastly_service_authorization" {
+			continue
+		}
+
+		conn := testAccProvider.Meta().(*FastlyClient).conn
+		_, err := getServiceAuthorization(conn, rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("[WARN] Tried deleting Service Authorization (%s), but was still found", rs.Primary.ID)
+		}
+		if e, ok := err.(*gofastly.HTTPError); !ok || !e.IsNotFound() {
+			return fmt.Errorf("[WARN] Error looking up Service Authorization (%s): %s", rs.Primary.ID, err)
+		}
+	}
+	return nil
+}
+
+func testAccServiceAuthorizationConfig(serviceName, domainName, login, userName, permission string) string {
+	return fmt.Sprintf(`
+resource "fastly_service_vcl" "foo" {
+  name = "%s"
+
+  domain {
+    name    = "%s"
+    comment = "tf-testing-domain"
+  }
+
+  backend {
+    address = "aws.amazon.com"
+    name    = "amazon docs"
+  }
+
+  force_destroy = true
+}
+
+resource "fastly_user" "foo" {
+  login = "%s"
+  name  = "%s"
+}
+
+resource "fastly_service_authorization" "foo" {
+  service_id = fastly_service_vcl.foo.id
+  user_id    = fastly_user.foo.id
+  permission = "%s"
+}`, serviceName, domainName, login, userName, permission)
+}
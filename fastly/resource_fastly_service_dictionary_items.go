@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"log"
+	"sort"
 	"strings"
 
 	gofastly "github.com/fastly/go-fastly/v6/fastly"
@@ -38,7 +40,7 @@ func resourceServiceDictionaryItems() *schema.Resource {
 				Type:        schema.TypeBool,
 				Default:     false,
 				Optional:    true,
-				Description: "Whether to reapply changes if the state of the items drifts, i.e. if items are managed externally",
+				Description: "Whether to reapply changes if the state of the items drifts, i.e. if items are managed externally. When `false` the items are only seeded on creation: later changes are not applied and the items are left in place when the resource is destroyed",
 			},
 
 			"items": {
@@ -90,57 +92,60 @@ func resourceServiceDictionaryItemsUpdate(ctx context.Context, d *schema.Resourc
 	serviceID := d.Get("service_id").(string)
 	dictionaryID := d.Get("dictionary_id").(string)
 
-	if d.HasChange("items") {
-
-		var batchDictionaryItems []*gofastly.BatchDictionaryItem
+	// NOTE: when manage_items is false the items are only observed, so we
+	// never push changes to the remote dictionary (even if the user has just
+	// flipped manage_items off in the same apply).
+	if d.HasChange("items") && d.Get("manage_items").(bool) {
 
 		o, n := d.GetChange("items")
+		batchDictionaryItems := dictionaryItemsBatchOperations(o.(map[string]interface{}), n.(map[string]interface{}))
 
-		os := o.(map[string]interface{})
-		ns := n.(map[string]interface{})
-
-		// Handle Removal
-		for key := range os {
-			if _, ok := ns[key]; !ok {
-
-				batchDictionaryItems = append(batchDictionaryItems, &gofastly.BatchDictionaryItem{
-					Operation: gofastly.DeleteBatchOperation,
-					ItemKey:   key,
-				})
-			}
+		// Process the batch operations
+		err := executeBatchDictionaryOperations(conn, serviceID, dictionaryID, batchDictionaryItems)
+		if err != nil {
+			return diag.Errorf("Error updating dictionary items: service %s, dictionary %s, %s", serviceID, dictionaryID, err)
 		}
+	}
 
-		for key, val := range ns {
-			// Handle replaces
-			if _, ok := os[key]; ok {
-
-				batchDictionaryItems = append(batchDictionaryItems, &gofastly.BatchDictionaryItem{
-					Operation: gofastly.UpdateBatchOperation,
-					ItemKey:   key,
-					ItemValue: val.(string),
-				})
-			}
-
-			// Handle additions
-			if _, ok := os[key]; !ok {
+	return resourceServiceDictionaryItemsRead(ctx, d, meta)
+}
 
-				batchDictionaryItems = append(batchDictionaryItems, &gofastly.BatchDictionaryItem{
-					Operation: gofastly.CreateBatchOperation,
-					ItemKey:   key,
-					ItemValue: val.(string),
-				})
+// dictionaryItemsBatchOperations returns the batch operations that turn the old items into the new ones, ordered by
+// key. Items whose value hasn't changed are left out, so that an update only spends batches on the changed keys.
+func dictionaryItemsBatchOperations(oldItems, newItems map[string]interface{}) []*gofastly.BatchDictionaryItem {
+	var batchDictionaryItems []*gofastly.BatchDictionaryItem
 
-			}
+	for key := range oldItems {
+		if _, ok := newItems[key]; !ok {
+			batchDictionaryItems = append(batchDictionaryItems, &gofastly.BatchDictionaryItem{
+				Operation: gofastly.DeleteBatchOperation,
+				ItemKey:   key,
+			})
 		}
+	}
 
-		// Process the batch operations
-		err := executeBatchDictionaryOperations(conn, serviceID, dictionaryID, batchDictionaryItems)
-		if err != nil {
-			return diag.Errorf("Error updating dictionary items: service %s, dictionary %s, %s", serviceID, dictionaryID, err)
+	for key, val := range newItems {
+		oldVal, ok := oldItems[key]
+		switch {
+		case !ok:
+			batchDictionaryItems = append(batchDictionaryItems, &gofastly.BatchDictionaryItem{
+				Operation: gofastly.CreateBatchOperation,
+				ItemKey:   key,
+				ItemValue: val.(string),
+			})
+		case oldVal != val:
+			batchDictionaryItems = append(batchDictionaryItems, &gofastly.BatchDictionaryItem{
+				Operation: gofastly.UpdateBatchOperation,
+				ItemKey:   key,
+				ItemValue: val.(string),
+			})
 		}
 	}
 
-	return resourceServiceDictionaryItemsRead(ctx, d, meta)
+	sort.Slice(batchDictionaryItems, func(i, j int) bool {
+		return batchDictionaryItems[i].ItemKey < batchDictionaryItems[j].ItemKey
+	})
+	return batchDictionaryItems
 }
 
 func resourceServiceDictionaryItemsRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -168,6 +173,14 @@ func resourceServiceDictionaryItemsDelete(_ context.Context, d *schema.ResourceD
 	dictionaryID := d.Get("dictionary_id").(string)
 	items := d.Get("items").(map[string]interface{})
 
+	// The items in state reflect the remote dictionary, which may be managed
+	// externally. Only remove them if Terraform has been told to manage them.
+	if !d.Get("manage_items").(bool) {
+		log.Printf("[INFO] Leaving dictionary items in place for service %s, dictionary %s as manage_items is false", serviceID, dictionaryID)
+		d.SetId("")
+		return nil
+	}
+
 	var batchDictionaryItems []*gofastly.BatchDictionaryItem
 
 	for key := range items {
@@ -187,7 +200,7 @@ func resourceServiceDictionaryItemsDelete(_ context.Context, d *schema.ResourceD
 	return nil
 }
 
-func resourceServiceDictionaryItemsImport(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+func resourceServiceDictionaryItemsImport(_ context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	split := strings.Split(d.Id(), "/")
 
 	if len(split) != 2 {
@@ -207,9 +220,52 @@ func resourceServiceDictionaryItemsImport(_ context.Context, d *schema.ResourceD
 		return nil, fmt.Errorf("Error importing dictionary items: service %s, dictionary %s, %s", serviceID, dictionaryID, err)
 	}
 
+	// Items of a write-only (private) dictionary can't be read back, so importing them would leave the state
+	// permanently out of sync with the remote dictionary.
+	conn := meta.(*FastlyClient).conn
+	writeOnly, err := isDictionaryWriteOnly(conn, serviceID, dictionaryID)
+	if err != nil {
+		return nil, fmt.Errorf("Error importing dictionary items: service %s, dictionary %s, %s", serviceID, dictionaryID, err)
+	}
+	if writeOnly {
+		return nil, fmt.Errorf("Error importing dictionary items: service %s, dictionary %s is write-only and its items can not be managed by Terraform", serviceID, dictionaryID)
+	}
+
 	return []*schema.ResourceData{d}, nil
 }
 
+// isDictionaryWriteOnly looks up the dictionary on the latest version of the service and reports whether it is a
+// write-only (private) dictionary.
+func isDictionaryWriteOnly(conn *gofastly.Client, serviceID, dictionaryID string) (bool, error) {
+	s, err := conn.GetServiceDetails(&gofastly.GetServiceInput{
+		ID: serviceID,
+	})
+	if err != nil {
+		return false, err
+	}
+
+	version := s.ActiveVersion.Number
+	if version == 0 {
+		version = s.Version.Number
+	}
+
+	dictList, err := conn.ListDictionaries(&gofastly.ListDictionariesInput{
+		ServiceID:      serviceID,
+		ServiceVersion: version,
+	})
+	if err != nil {
+		return false, err
+	}
+
+	for _, dict := range dictList {
+		if dict.ID == dictionaryID {
+			return dict.WriteOnly, nil
+		}
+	}
+
+	return false, fmt.Errorf("dictionary not found in version %d of the service", version)
+}
+
 func flattenDictionaryItems(dictItemList []*gofastly.DictionaryItem) map[string]string {
 	resultList := make(map[string]string)
 	for _, currentDictItem := range dictItemList {
@@ -1,14 +1,18 @@
 package fastly
 
 import (
+	"context"
 	"fmt"
+	"net/http"
 	"reflect"
 	"strconv"
+	"strings"
 	"testing"
 
 	gofastly "github.com/fastly/go-fastly/v6/fastly"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
@@ -37,6 +41,21 @@ func TestResourceFastlyFlattenDictionaryItems(t *testing.T) {
 				"key-2": "value-2",
 			},
 		},
+		{
+			// Keys must be preserved byte-for-byte, as dictionary lookups are exact matches.
+			remote: []*gofastly.DictionaryItem{
+				{ItemKey: "Key", ItemValue: "upper"},
+				{ItemKey: "key", ItemValue: "lower"},
+				{ItemKey: "trailing ", ItemValue: "trailing space"},
+				{ItemKey: " leading", ItemValue: "leading space"},
+			},
+			local: map[string]string{
+				"Key":       "upper",
+				"key":       "lower",
+				"trailing ": "trailing space",
+				" leading":  "leading space",
+			},
+		},
 	}
 
 	for _, c := range cases {
@@ -47,6 +66,92 @@ func TestResourceFastlyFlattenDictionaryItems(t *testing.T) {
 	}
 }
 
+func TestDictionaryItemsBatchOperations(t *testing.T) {
+	out := dictionaryItemsBatchOperations(
+		map[string]interface{}{"deleted": "a", "unchanged": "b", "updated": "c"},
+		map[string]interface{}{"created": "d", "unchanged": "b", "updated": "e"},
+	)
+
+	expected := []*gofastly.BatchDictionaryItem{
+		{Operation: gofastly.CreateBatchOperation, ItemKey: "created", ItemValue: "d"},
+		{Operation: gofastly.DeleteBatchOperation, ItemKey: "deleted"},
+		{Operation: gofastly.UpdateBatchOperation, ItemKey: "updated", ItemValue: "e"},
+	}
+	if !reflect.DeepEqual(out, expected) {
+		t.Fatalf("Error matching:\nexpected: %#v\ngot: %#v", expected, out)
+	}
+}
+
+func TestResourceFastlyIsDictionaryWriteOnly(t *testing.T) {
+	for _, testcase := range []struct {
+		name          string
+		activeVersion int
+		dictionaryID  string
+		expected      bool
+		expectError   bool
+	}{
+		{"write-only", 2, "private", true, false},
+		{"readable", 2, "public", false, false},
+		// Without an active version, the latest version is looked up.
+		{"inactive service", 0, "private", true, false},
+		{"missing dictionary", 2, "unknown", false, true},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			lookedUpVersion := 2
+			if testcase.activeVersion == 0 {
+				lookedUpVersion = 3
+			}
+
+			conn := testFastlyClient(t, func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				switch r.URL.Path {
+				case "/service/123/details":
+					fmt.Fprintf(w, `{"id": "123", "active_version": {"number": %d}, "version": {"number": 3}}`, testcase.activeVersion)
+				case fmt.Sprintf("/service/123/version/%d/dictionary", lookedUpVersion):
+					_, _ = w.Write([]byte(`[{"id": "public", "name": "public", "write_only": false}, {"id": "private", "name": "private", "write_only": true}]`))
+				default:
+					t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+					w.WriteHeader(http.StatusNotFound)
+				}
+			})
+
+			writeOnly, err := isDictionaryWriteOnly(conn, "123", testcase.dictionaryID)
+			if testcase.expectError && err == nil {
+				t.Fatal("expected an error, got none")
+			}
+			if !testcase.expectError && err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if writeOnly != testcase.expected {
+				t.Errorf("expected write-only to be %t, got %t", testcase.expected, writeOnly)
+			}
+		})
+	}
+}
+
+func TestResourceFastlyDictionaryItemsImportWriteOnly(t *testing.T) {
+	conn := testFastlyClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/service/123/details":
+			_, _ = w.Write([]byte(`{"id": "123", "active_version": {"number": 2}}`))
+		case "/service/123/version/2/dictionary":
+			_, _ = w.Write([]byte(`[{"id": "private", "name": "private", "write_only": true}]`))
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	d := schema.TestResourceDataRaw(t, resourceServiceDictionaryItems().Schema, map[string]interface{}{})
+	d.SetId("123/private")
+
+	_, err := resourceServiceDictionaryItemsImport(context.Background(), d, &FastlyClient{conn: conn})
+	if err == nil || !strings.Contains(err.Error(), "is write-only") {
+		t.Fatalf("expected the import of a write-only dictionary to be rejected, got %v", err)
+	}
+}
+
 func TestAccFastlyServiceDictionaryItem_create(t *testing.T) {
 	var service gofastly.ServiceDetail
 	name := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
@@ -80,6 +185,39 @@ func TestAccFastlyServiceDictionaryItem_create(t *testing.T) {
 	})
 }
 
+// TestAccFastlyServiceDictionaryItem_create_edge_case_keys validates that keys
+// differing only in case or surrounding whitespace are stored and read back as
+// distinct items, without any normalization.
+func TestAccFastlyServiceDictionaryItem_create_edge_case_keys(t *testing.T) {
+	var service gofastly.ServiceDetail
+	name := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	dictName := fmt.Sprintf("dict %s", acctest.RandString(10))
+
+	expectedRemoteItems := map[string]string{
+		"Key":       "upper",
+		"key":       "lower",
+		"trailing ": "trailing space",
+		" leading":  "leading space",
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckServiceVCLDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceDictionaryItemsConfig_one_dictionary_with_items(name, dictName, expectedRemoteItems, true, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceVCLExists("fastly_service_vcl.foo", &service),
+					testAccCheckFastlyServiceDictionaryItemsRemoteState(&service, name, dictName, expectedRemoteItems),
+					resource.TestCheckResourceAttr("fastly_service_dictionary_items.items", "items.%", "4"),
+					resource.TestCheckResourceAttr("fastly_service_dictionary_items.items", "items.trailing ", "trailing space"),
+				),
+			},
+		},
+	})
+}
+
 // TestAccFastlyServiceDictionaryItem_create_inactive_service validates that
 // when creating a new inactive service consisting of a dictionary along with a
 // predefined list of items to populate it with, are applied successfully
@@ -339,6 +477,61 @@ func TestAccFastlyServiceDictionaryItem_manage_items_false(t *testing.T) {
 	})
 }
 
+func TestAccFastlyServiceDictionaryItem_manage_items_false_items_survive(t *testing.T) {
+	var service gofastly.ServiceDetail
+	name := acctest.RandomWithPrefix(testResourcePrefix)
+	dictName := fmt.Sprintf("dict %s", acctest.RandString(10))
+
+	initialItems := map[string]string{
+		"key1": "value1",
+		"key2": "value2",
+	}
+
+	updatedItems := map[string]string{
+		"key1": "valueOne",
+	}
+
+	expectedRemoteItems := map[string]string{
+		"key1": "value1",
+		"key2": "value2",
+		"key3": "value3",
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckServiceVCLDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceDictionaryItemsConfig_one_dictionary_with_items(name, dictName, initialItems, true, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceVCLExists("fastly_service_vcl.foo", &service),
+					testAccCheckFastlyServiceDictionaryItemsRemoteState(&service, name, dictName, initialItems),
+				),
+			},
+			{
+				// Flip manage_items off while changing the items: nothing must be pushed remotely.
+				PreConfig: func() { createDictionaryItemThroughApi(t, &service, dictName, "key3", "value3") },
+				Config:    testAccServiceDictionaryItemsConfig_one_dictionary_with_items(name, dictName, updatedItems, true, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceVCLExists("fastly_service_vcl.foo", &service),
+					testAccCheckFastlyServiceDictionaryItemsRemoteState(&service, name, dictName, expectedRemoteItems),
+					resource.TestCheckResourceAttr("fastly_service_dictionary_items.items", "manage_items", "false"),
+				),
+			},
+			{
+				// Removing the resource must leave the items in place.
+				Config: testAccServiceDictionaryItemsConfig_one_dictionary_no_items(name, dictName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceVCLExists("fastly_service_vcl.foo", &service),
+					testAccCheckFastlyServiceDictionaryItemsRemoteState(&service, name, dictName, expectedRemoteItems),
+					testAccCheckFastlyServiceDictionaryItemsDoesNotExists("fastly_service_dictionary_items.items"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckFastlyServiceDictionaryItemsDoesNotExists(n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		_, ok := s.RootModule().Resources[n]
@@ -428,7 +621,7 @@ func testAccServiceDictionaryItemsConfig_one_dictionary_with_items(serviceName,
 	var dictItems = "{\n"
 
 	for key, value := range dictItemsList {
-		dictItems += fmt.Sprintf("%s: \"%s\"\n", key, value)
+		dictItems += fmt.Sprintf("%q: %q\n", key, value)
 	}
 
 	dictItems += "}\n"
@@ -37,6 +37,7 @@ var computeService = &BaseServiceDefinition{
 		NewServiceLoggingGooglePubSub(computeAttributes),
 		NewServiceLoggingScalyr(computeAttributes),
 		NewServiceLoggingNewRelic(computeAttributes),
+		NewServiceLoggingNewRelicOTLP(computeAttributes),
 		NewServiceLoggingKafka(computeAttributes),
 		NewServiceLoggingHeroku(computeAttributes),
 		NewServiceLoggingHoneycomb(computeAttributes),
@@ -1,15 +1,21 @@
 package fastly
 
 import (
+	"context"
 	"fmt"
+	"net/http"
 	"reflect"
 	"regexp"
+	"sort"
 	"strings"
 	"testing"
 
 	gofastly "github.com/fastly/go-fastly/v6/fastly"
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
@@ -52,6 +58,20 @@ func TestResourceFastlyFlattenDomains(t *testing.T) {
 				},
 			},
 		},
+		{
+			remote: []*gofastly.Domain{
+				{
+					Name:    "test.notexample.com",
+					Comment: "  Production: \"www\" & <apex> — ünïcode  ",
+				},
+			},
+			local: []map[string]interface{}{
+				{
+					"name":    "test.notexample.com",
+					"comment": "  Production: \"www\" & <apex> — ünïcode  ",
+				},
+			},
+		},
 	}
 
 	for _, c := range cases {
@@ -62,6 +82,182 @@ func TestResourceFastlyFlattenDomains(t *testing.T) {
 	}
 }
 
+func TestResourceFastlyDomainEmptyCommentHash(t *testing.T) {
+	h := &DomainServiceAttributeHandler{
+		&DefaultServiceAttributeHandler{
+			key:             "domain",
+			serviceMetadata: ServiceMetadata{serviceType: ServiceTypeVCL},
+		},
+	}
+	hash := schema.HashResource(h.GetSchema().Elem.(*schema.Resource))
+
+	// A domain read back with an empty comment must match one configured without a comment, otherwise the set
+	// element is replaced on every plan.
+	unset := hash(map[string]interface{}{"name": "test.notexample.com"})
+	empty := hash(map[string]interface{}{"name": "test.notexample.com", "comment": ""})
+	if unset != empty {
+		t.Fatalf("Expected unset and empty comments to hash equally, got %d and %d", unset, empty)
+	}
+}
+
+func TestResourceFastlyLoggingSecretsSensitive(t *testing.T) {
+	// Attributes carrying credentials must be redacted from plan output.
+	secrets := map[string]bool{
+		"access_key":     true,
+		"password":       true,
+		"s3_access_key":  true,
+		"s3_secret_key":  true,
+		"sas_token":      true,
+		"secret_key":     true,
+		"tls_client_key": true,
+		"token":          true,
+		// Block specific attributes, for URLs that embed a token.
+		"logging_heroku.url":    true,
+		"logging_sumologic.url": true,
+	}
+
+	for _, r := range []*schema.Resource{resourceServiceVCL(), resourceServiceCompute()} {
+		for key, s := range r.Schema {
+			if !strings.HasPrefix(key, "logging_") {
+				continue
+			}
+			for name, attr := range s.Elem.(*schema.Resource).Schema {
+				if (secrets[name] || secrets[key+"."+name]) && !attr.Sensitive {
+					t.Errorf("Expected %s.%s to be marked as a Sensitive value", key, name)
+				}
+			}
+		}
+	}
+}
+
+func TestResourceFastlyImportSetsDrainTimeout(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceServiceVCL().Schema, map[string]interface{}{})
+	d.SetId("nci48cow8ncw8ocn75@3")
+
+	result, err := resourceImport().StateContext(context.Background(), d, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	// Without it, ImportStateVerify reports drain_timeout as missing from the imported state.
+	if got := result[0].State().Attributes["drain_timeout"]; got != "0" {
+		t.Errorf("expected drain_timeout to be imported with its default of 0, got %q", got)
+	}
+}
+
+func TestResourceFastlyIsVersionLocked(t *testing.T) {
+	cases := map[string]struct {
+		status      int
+		body        string
+		expectLock  bool
+		expectError bool
+	}{
+		"locked":   {status: http.StatusOK, body: `{"number": 1, "locked": true}`, expectLock: true},
+		"unlocked": {status: http.StatusOK, body: `{"number": 1, "locked": false}`},
+		"error":    {status: http.StatusInternalServerError, body: `{"msg": "oops"}`, expectError: true},
+	}
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			conn := testFastlyClient(t, func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/service/123/version/1" {
+					t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+				}
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(c.status)
+				_, _ = w.Write([]byte(c.body))
+			})
+
+			locked, err := isVersionLocked(conn, "123", 1)
+			if (err != nil) != c.expectError {
+				t.Fatalf("expected error %v, got %v", c.expectError, err)
+			}
+			if locked != c.expectLock {
+				t.Errorf("expected locked to be %v, got %v", c.expectLock, locked)
+			}
+		})
+	}
+}
+
+func TestResourceFastlyServiceResourceName(t *testing.T) {
+	for serviceType, want := range map[string]string{
+		ServiceTypeVCL:     "fastly_service_vcl",
+		ServiceTypeCompute: "fastly_service_compute",
+	} {
+		if got := serviceResourceName(serviceType); got != want {
+			t.Errorf("expected %s services to be managed by %s, got %s", serviceType, want, got)
+		}
+	}
+}
+
+func TestResourceFastlyServiceReadTypeMismatch(t *testing.T) {
+	conn := testFastlyClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/service/123/details" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id": "123", "name": "test", "type": "wasm"}`))
+	})
+
+	d := schema.TestResourceDataRaw(t, resourceServiceVCL().Schema, map[string]interface{}{})
+	d.SetId("123")
+	diags := resourceServiceRead(context.Background(), d, &FastlyClient{conn: conn}, vclService)
+	if !diags.HasError() {
+		t.Fatal("expected an error reading a Compute service as a VCL service")
+	}
+	want := "[ERR] Service type mismatch in READ, expected: vcl, got: wasm. Use the fastly_service_compute resource to manage service (123)"
+	if diags[0].Summary != want {
+		t.Errorf("expected %q, got %q", want, diags[0].Summary)
+	}
+}
+
+func TestResourceFastlyServiceReadNoVersion(t *testing.T) {
+	// A service without an active or drafted version has no settings, or any other versioned
+	// configuration, to read, so only the service details may be requested.
+	conn := testFastlyClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/service/123/details" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id": "123", "name": "test", "type": "vcl", "versions": []}`))
+	})
+
+	d := schema.TestResourceDataRaw(t, resourceServiceVCL().Schema, map[string]interface{}{})
+	d.SetId("123")
+	if diags := resourceServiceRead(context.Background(), d, &FastlyClient{conn: conn}, vclService); diags.HasError() {
+		t.Fatalf("unexpected error: %#v", diags)
+	}
+	if v := d.Get("active_version").(int); v != 0 {
+		t.Errorf("expected active_version to be 0, got %d", v)
+	}
+	if v := d.Get("cloned_version").(int); v != 0 {
+		t.Errorf("expected cloned_version to be 0, got %d", v)
+	}
+}
+
+func TestResourceFastlyDrainService(t *testing.T) {
+	// Without a drain_timeout the service is deactivated straight away.
+	d := schema.TestResourceDataRaw(t, resourceServiceVCL().Schema, map[string]interface{}{"name": "test"})
+	if err := drainService(context.Background(), d); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// Draining stops when the delete operation is cancelled or times out.
+	d = schema.TestResourceDataRaw(t, resourceServiceVCL().Schema, map[string]interface{}{"name": "test", "drain_timeout": 3600})
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := drainService(ctx, d); err == nil {
+		t.Fatal("expected an error when the context is cancelled, got none")
+	}
+
+	// A drain that doesn't fit in the delete timeout fails straight away instead of at the deadline.
+	ctx, cancel = context.WithTimeout(context.Background(), serviceDeleteTimeout)
+	defer cancel()
+	if err := drainService(ctx, d); err == nil || !strings.Contains(err.Error(), "increase the delete timeout") {
+		t.Fatalf("expected an error about the delete timeout, got %v", err)
+	}
+}
+
 func TestResourceFastlyFlattenBackend(t *testing.T) {
 	cases := []struct {
 		serviceMetadata ServiceMetadata
@@ -141,6 +337,333 @@ func TestResourceFastlyFlattenBackend(t *testing.T) {
 	}
 }
 
+func TestResourceFastlyServiceWarnings(t *testing.T) {
+	// A new service has no prior state, so every block counts as changed, as it does when the service is created.
+	config := map[string]interface{}{
+		"name":       "test",
+		"backend":    []interface{}{map[string]interface{}{"name": "origin", "address": "example.com"}},
+		"logging_s3": []interface{}{map[string]interface{}{"name": "s3", "bucket_name": "bucket", "gzip_level": 9, "period": 60}},
+	}
+
+	var summaries []string
+	for _, d := range serviceWarnings(schema.TestResourceDataRaw(t, resourceServiceVCL().Schema, config), vclService) {
+		if d.Severity != diag.Warning {
+			t.Errorf("expected only warnings, got %#v", d)
+		}
+		summaries = append(summaries, d.Summary)
+	}
+	sort.Strings(summaries)
+
+	expected := []string{"Backend uses plaintext to a public address", "High gzip_level with a short S3 logging period"}
+	if diff := cmp.Diff(expected, summaries); diff != "" {
+		t.Fatalf("Error matching: %s", diff)
+	}
+}
+
+func TestResourceFastlyBackendPlaintextWarnings(t *testing.T) {
+	for _, c := range []struct {
+		name     string
+		backend  map[string]interface{}
+		expected int
+	}{
+		{
+			name:     "plaintext to public hostname",
+			backend:  map[string]interface{}{"name": "origin", "address": "example.com", "port": 80},
+			expected: 1,
+		},
+		{
+			name:     "plaintext to public hostname without port",
+			backend:  map[string]interface{}{"name": "origin", "address": "example.com"},
+			expected: 1,
+		},
+		{
+			name:     "tls to public hostname",
+			backend:  map[string]interface{}{"name": "origin", "address": "example.com", "port": 443, "use_ssl": true},
+			expected: 0,
+		},
+		{
+			name:     "plaintext to private address",
+			backend:  map[string]interface{}{"name": "origin", "address": "10.1.2.3", "port": 80},
+			expected: 0,
+		},
+		{
+			name:     "plaintext to public address",
+			backend:  map[string]interface{}{"name": "origin", "address": "203.0.113.10", "port": 80},
+			expected: 1,
+		},
+		{
+			name:     "plaintext on non-standard port",
+			backend:  map[string]interface{}{"name": "origin", "address": "example.com", "port": 8080},
+			expected: 0,
+		},
+	} {
+		t.Run(c.name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, resourceServiceVCL().Schema, map[string]interface{}{
+				"name":    "test",
+				"backend": []interface{}{c.backend},
+			})
+			diags := backendPlaintextWarnings(d)
+			if len(diags) != c.expected {
+				t.Fatalf("expected %d warnings, got %d: %#v", c.expected, len(diags), diags)
+			}
+		})
+	}
+
+	t.Run("unchanged plaintext backend", func(t *testing.T) {
+		d := schema.TestResourceDataRaw(t, resourceServiceVCL().Schema, map[string]interface{}{
+			"name":    "test",
+			"backend": []interface{}{map[string]interface{}{"name": "origin", "address": "example.com", "port": 80}},
+		})
+		d.SetId("test")
+		unchanged := resourceServiceVCL().Data(d.State())
+		if diags := backendPlaintextWarnings(unchanged); len(diags) != 0 {
+			t.Fatalf("expected no warnings for an unchanged backend, got %#v", diags)
+		}
+	})
+}
+
+func TestResourceFastlyBackendWeightWarnings(t *testing.T) {
+	for _, c := range []struct {
+		name      string
+		backends  []interface{}
+		directors []interface{}
+		expected  int
+	}{
+		{
+			name:     "default weight without director",
+			backends: []interface{}{map[string]interface{}{"name": "origin", "address": "example.com", "weight": 100}},
+			expected: 0,
+		},
+		{
+			name:     "weight without director",
+			backends: []interface{}{map[string]interface{}{"name": "origin", "address": "example.com", "weight": 50}},
+			expected: 1,
+		},
+		{
+			name: "weight with director",
+			backends: []interface{}{
+				map[string]interface{}{"name": "origin1", "address": "example.com", "weight": 50},
+				map[string]interface{}{"name": "origin2", "address": "example.net", "weight": 150},
+			},
+			directors: []interface{}{map[string]interface{}{"name": "director", "backends": []interface{}{"origin1", "origin2"}}},
+			expected:  0,
+		},
+		{
+			name: "weight on backend outside director",
+			backends: []interface{}{
+				map[string]interface{}{"name": "origin1", "address": "example.com", "weight": 50},
+				map[string]interface{}{"name": "origin2", "address": "example.net", "weight": 150},
+			},
+			directors: []interface{}{map[string]interface{}{"name": "director", "backends": []interface{}{"origin1"}}},
+			expected:  1,
+		},
+	} {
+		t.Run(c.name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, resourceServiceVCL().Schema, map[string]interface{}{
+				"name":     "test",
+				"backend":  c.backends,
+				"director": c.directors,
+			})
+			diags := backendWeightWarnings(d)
+			if len(diags) != c.expected {
+				t.Fatalf("expected %d warnings, got %d: %#v", c.expected, len(diags), diags)
+			}
+		})
+	}
+}
+
+func TestResourceFastlyBackendShieldWarnings(t *testing.T) {
+	for _, c := range []struct {
+		name      string
+		backends  []interface{}
+		directors []interface{}
+		expected  int
+	}{
+		{
+			name:      "same shield as director",
+			backends:  []interface{}{testBackend("origin1", "sea-wa-us"), testBackend("origin2", "")},
+			directors: []interface{}{testDirector("sea-wa-us", "origin1", "origin2")},
+			expected:  0,
+		},
+		{
+			name:      "different shield than director",
+			backends:  []interface{}{testBackend("origin1", "iad-va-us"), testBackend("origin2", "sea-wa-us")},
+			directors: []interface{}{testDirector("sea-wa-us", "origin1", "origin2")},
+			expected:  1,
+		},
+		{
+			name:      "backends with different shields",
+			backends:  []interface{}{testBackend("origin1", "iad-va-us"), testBackend("origin2", "sea-wa-us")},
+			directors: []interface{}{testDirector("", "origin1", "origin2")},
+			expected:  1,
+		},
+		{
+			name:     "different shields without director",
+			backends: []interface{}{testBackend("origin1", "iad-va-us"), testBackend("origin2", "sea-wa-us")},
+			expected: 0,
+		},
+	} {
+		t.Run(c.name, func(t *testing.T) {
+			d := testServiceVCLData(t, map[string][]interface{}{"backend": c.backends, "director": c.directors})
+			diags := backendShieldWarnings(d)
+			if len(diags) != c.expected {
+				t.Fatalf("expected %d warnings, got %d: %#v", c.expected, len(diags), diags)
+			}
+		})
+	}
+}
+
+func TestResourceFastlyBackendTimeoutWarnings(t *testing.T) {
+	for _, c := range []struct {
+		name     string
+		backend  map[string]interface{}
+		expected int
+	}{
+		{
+			name:     "default timeouts",
+			backend:  map[string]interface{}{"name": "origin", "address": "example.com"},
+			expected: 0,
+		},
+		{
+			name:     "first_byte_timeout shorter than connect_timeout",
+			backend:  map[string]interface{}{"name": "origin", "address": "example.com", "connect_timeout": 5000, "first_byte_timeout": 2000},
+			expected: 1,
+		},
+		{
+			name:     "both timeouts shorter than connect_timeout",
+			backend:  map[string]interface{}{"name": "origin", "address": "example.com", "connect_timeout": 5000, "first_byte_timeout": 2000, "between_bytes_timeout": 1000},
+			expected: 2,
+		},
+	} {
+		t.Run(c.name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, resourceServiceVCL().Schema, map[string]interface{}{
+				"name":    "test",
+				"backend": []interface{}{c.backend},
+			})
+			diags := backendTimeoutWarnings(d)
+			if len(diags) != c.expected {
+				t.Fatalf("expected %d warnings, got %d: %#v", c.expected, len(diags), diags)
+			}
+		})
+	}
+}
+
+func TestResourceFastlyServiceNameUpdatesInPlace(t *testing.T) {
+	for name, r := range map[string]*schema.Resource{
+		"vcl":     resourceServiceVCL(),
+		"compute": resourceServiceCompute(),
+	} {
+		if r.Schema["name"].ForceNew {
+			t.Errorf("%s: changing the service name should not force a new resource", name)
+		}
+	}
+}
+
+func TestResourceFastlyBackendIPTLSWarnings(t *testing.T) {
+	for _, c := range []struct {
+		name     string
+		backend  map[string]interface{}
+		expected int
+	}{
+		{
+			name:     "IP address without a hostname",
+			backend:  map[string]interface{}{"name": "origin", "address": "203.0.113.10", "port": 443, "use_ssl": true},
+			expected: 1,
+		},
+		{
+			name:     "IP address with ssl_cert_hostname",
+			backend:  map[string]interface{}{"name": "origin", "address": "203.0.113.10", "port": 443, "use_ssl": true, "ssl_cert_hostname": "origin.example.com"},
+			expected: 0,
+		},
+		{
+			name:     "IP address with ssl_sni_hostname",
+			backend:  map[string]interface{}{"name": "origin", "address": "203.0.113.10", "port": 443, "use_ssl": true, "ssl_sni_hostname": "origin.example.com"},
+			expected: 0,
+		},
+		{
+			name:     "IP address without certificate checks",
+			backend:  map[string]interface{}{"name": "origin", "address": "203.0.113.10", "port": 443, "use_ssl": true, "ssl_check_cert": false},
+			expected: 0,
+		},
+		{
+			name:     "IP address without TLS",
+			backend:  map[string]interface{}{"name": "origin", "address": "203.0.113.10"},
+			expected: 0,
+		},
+		{
+			name:     "hostname",
+			backend:  map[string]interface{}{"name": "origin", "address": "origin.example.com", "port": 443, "use_ssl": true},
+			expected: 0,
+		},
+	} {
+		t.Run(c.name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, resourceServiceVCL().Schema, map[string]interface{}{
+				"name":    "test",
+				"backend": []interface{}{c.backend},
+			})
+			diags := backendIPTLSWarnings(d)
+			if len(diags) != c.expected {
+				t.Fatalf("expected %d warnings, got %d: %#v", c.expected, len(diags), diags)
+			}
+		})
+	}
+}
+
+func TestResourceFastlyFlattenBackendSSLCertHostnameWildcard(t *testing.T) {
+	out := flattenBackend([]*gofastly.Backend{
+		{
+			Name:            "test.notexample.com",
+			Address:         "www.notexample.com",
+			SSLCertHostname: "*.example.com",
+		},
+	}, ServiceMetadata{serviceType: ServiceTypeVCL})
+
+	// ssl_cert_hostname must round-trip exactly, without stripping the wildcard.
+	if got := out[0]["ssl_cert_hostname"]; got != "*.example.com" {
+		t.Fatalf("Error matching ssl_cert_hostname:\nexpected: %#v\n     got: %#v", "*.example.com", got)
+	}
+}
+
+func TestResourceFastlyLoggingFormatVersionWarnings(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceServiceVCL().Schema, map[string]interface{}{
+		"name": "test",
+		"logging_syslog": []interface{}{
+			map[string]interface{}{
+				"name":           "legacy",
+				"address":        "127.0.0.1",
+				"format_version": 1,
+			},
+			map[string]interface{}{
+				"name":           "current",
+				"address":        "127.0.0.1",
+				"format_version": 2,
+			},
+		},
+		"logging_papertrail": []interface{}{
+			map[string]interface{}{
+				"name":           "legacy papertrail",
+				"address":        "127.0.0.1",
+				"port":           514,
+				"format_version": 1,
+			},
+		},
+	})
+	d.SetId("service-id")
+
+	diags := loggingFormatVersionWarnings(d, vclService)
+	if len(diags) != 2 {
+		t.Fatalf("expected 2 warnings, got %d: %#v", len(diags), diags)
+	}
+	for _, w := range diags {
+		if w.Severity != diag.Warning {
+			t.Errorf("expected warning severity, got %#v", w)
+		}
+		if strings.Contains(w.Detail, "'current'") {
+			t.Errorf("unexpected warning for format_version 2 endpoint: %s", w.Detail)
+		}
+	}
+}
+
 func TestAccFastlyServiceVCL_updateDomain(t *testing.T) {
 	var service gofastly.ServiceDetail
 	name := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
@@ -191,6 +714,14 @@ func TestAccFastlyServiceVCL_updateDomain(t *testing.T) {
 						"fastly_service_vcl.foo", "active_version", "3"),
 					resource.TestCheckResourceAttr(
 						"fastly_service_vcl.foo", "domain.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("fastly_service_vcl.foo", "domain.*", map[string]string{
+						"name":    domainName1,
+						"comment": "tf-testing-domain-updated",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("fastly_service_vcl.foo", "domain.*", map[string]string{
+						"name":    domainName2,
+						"comment": "tf-testing-other-domain",
+					}),
 				),
 			},
 
@@ -209,6 +740,56 @@ func TestAccFastlyServiceVCL_updateDomain(t *testing.T) {
 	})
 }
 
+func TestResourceFastlyBuildUpdateBackendInputClearHostnames(t *testing.T) {
+	h := &BackendServiceAttributeHandler{
+		&DefaultServiceAttributeHandler{
+			key:             "backend",
+			serviceMetadata: ServiceMetadata{serviceType: ServiceTypeVCL},
+		},
+	}
+
+	for _, c := range []struct {
+		attribute string
+		sent      func(gofastly.UpdateBackendInput) *string
+	}{
+		{
+			attribute: "override_host",
+			sent:      func(opts gofastly.UpdateBackendInput) *string { return opts.OverrideHost },
+		},
+		{
+			attribute: "ssl_sni_hostname",
+			sent:      func(opts gofastly.UpdateBackendInput) *string { return opts.SSLSNIHostname },
+		},
+	} {
+		t.Run(c.attribute, func(t *testing.T) {
+			old := map[string]interface{}{
+				"name":      "test.notexample.com",
+				"address":   "www.notexample.com",
+				c.attribute: "www.example.com",
+			}
+			resource := map[string]interface{}{
+				"name":      "test.notexample.com",
+				"address":   "www.notexample.com",
+				c.attribute: "",
+			}
+			oldSet := schema.NewSet(schema.HashResource(h.GetSchema().Elem.(*schema.Resource)), []interface{}{old})
+
+			modified := NewSetDiff(func(resource interface{}) (interface{}, error) {
+				return resource.(map[string]interface{})["name"], nil
+			}).Filter(resource, oldSet)
+			opts := h.buildUpdateBackendInput("service", 1, resource, modified)
+
+			// A non-nil empty string is sent to the API, clearing the previously set value.
+			if v := c.sent(opts); v == nil || *v != "" {
+				t.Fatalf("Error matching %s:\nexpected: %#v\n     got: %#v", c.attribute, gofastly.String(""), v)
+			}
+			if opts.Address != nil {
+				t.Fatalf("Expected unchanged address not to be sent, got: %#v", *opts.Address)
+			}
+		})
+	}
+}
+
 func TestAccFastlyServiceVCL_updateBackend(t *testing.T) {
 	var service gofastly.ServiceDetail
 	name := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
@@ -244,6 +825,119 @@ func TestAccFastlyServiceVCL_updateBackend(t *testing.T) {
 	})
 }
 
+func TestAccFastlyServiceVCL_backendClearOverrideHost(t *testing.T) {
+	var service gofastly.ServiceDetail
+	name := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	domain := fmt.Sprintf("fastly-test.tf-%s.com", acctest.RandString(10))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckServiceVCLDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceVCLConfig_backendOverrideHost(name, domain, "www.example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceVCLExists("fastly_service_vcl.foo", &service),
+					testAccCheckFastlyServiceVCLBackendOverrideHost(&service, "www.example.com"),
+				),
+			},
+			{
+				Config: testAccServiceVCLConfig_backendOverrideHost(name, domain, ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceVCLExists("fastly_service_vcl.foo", &service),
+					testAccCheckFastlyServiceVCLBackendOverrideHost(&service, ""),
+					resource.TestCheckResourceAttr(
+						"fastly_service_vcl.foo", "active_version", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccFastlyServiceVCL_backendClearSSLSNIHostname(t *testing.T) {
+	var service gofastly.ServiceDetail
+	name := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	domain := fmt.Sprintf("fastly-test.tf-%s.com", acctest.RandString(10))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckServiceVCLDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceVCLConfig_backendSSLSNIHostname(name, domain, "aws.amazon.com"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceVCLExists("fastly_service_vcl.foo", &service),
+					testAccCheckFastlyServiceVCLBackendSSLSNIHostname(&service, "aws.amazon.com"),
+				),
+			},
+			{
+				Config: testAccServiceVCLConfig_backendSSLSNIHostname(name, domain, ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceVCLExists("fastly_service_vcl.foo", &service),
+					testAccCheckFastlyServiceVCLBackendSSLSNIHostname(&service, ""),
+					resource.TestCheckResourceAttr(
+						"fastly_service_vcl.foo", "active_version", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestResourceFastlyServiceVersionComment(t *testing.T) {
+	s := &gofastly.ServiceDetail{
+		ActiveVersion: gofastly.Version{Number: 1, Comment: "active"},
+		Versions: []*gofastly.Version{
+			{Number: 1, Comment: "active"},
+			{Number: 2, Comment: "drafted"},
+		},
+	}
+
+	if got := serviceVersionComment(s, 2); got != "drafted" {
+		t.Fatalf("expected the comment of the drafted version, got %q", got)
+	}
+	if got := serviceVersionComment(s, 1); got != "active" {
+		t.Fatalf("expected the comment of the active version, got %q", got)
+	}
+	if got := serviceVersionComment(s, 3); got != "active" {
+		t.Fatalf("expected the comment of the active version for an unlisted version, got %q", got)
+	}
+}
+
+// TestAccFastlyServiceVCL_activateFalseReplan tests that a service whose changes are never activated can be planned
+// again without a diff, as its state is read from the drafted version instead of the active one.
+func TestAccFastlyServiceVCL_activateFalseReplan(t *testing.T) {
+	var service gofastly.ServiceDetail
+	name := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	domain := fmt.Sprintf("fastly-test.tf-%s.com", acctest.RandString(10))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckServiceVCLDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceVCLConfig_activateFalse(name, domain, "drafted version"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceVCLExists("fastly_service_vcl.foo", &service),
+					resource.TestCheckResourceAttr(
+						"fastly_service_vcl.foo", "active_version", "0"),
+					resource.TestCheckResourceAttr(
+						"fastly_service_vcl.foo", "cloned_version", "1"),
+					resource.TestCheckResourceAttr(
+						"fastly_service_vcl.foo", "version_comment", "drafted version"),
+				),
+			},
+			// re-planning the unchanged config must not produce a diff
+			{
+				Config:   testAccServiceVCLConfig_activateFalse(name, domain, "drafted version"),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 // TestAccFastlyServiceVCL_activateNewVersionExternally tests whether things break when a new version is cloned and
 // activated outside of Terraform. There has been a bug where the version used for reading the state, and the version
 // that gets cloned in order to make updates, are different when a new version is activated externally. In this case, a
@@ -476,6 +1170,127 @@ func TestAccFastlyServiceVCL_basic(t *testing.T) {
 	})
 }
 
+func TestAccFastlyServiceVCL_lockedVersionComment(t *testing.T) {
+	var service gofastly.ServiceDetail
+	name := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	versionComment1 := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	versionComment2 := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	domainName := fmt.Sprintf("fastly-test.tf-%s.com", acctest.RandString(10))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckServiceVCLDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceVCLConfig_initWithVerstionComment(name, versionComment1, domainName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceVCLExists("fastly_service_vcl.foo", &service),
+					resource.TestCheckResourceAttr(
+						"fastly_service_vcl.foo", "version_comment", versionComment1),
+					resource.TestCheckResourceAttr(
+						"fastly_service_vcl.foo", "active_version", "1"),
+				),
+			},
+			{
+				// version 1 is active and so locked, so changing only the version comment clones it
+				Config: testAccServiceVCLConfig_initWithVerstionComment(name, versionComment2, domainName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceVCLExists("fastly_service_vcl.foo", &service),
+					resource.TestCheckResourceAttr(
+						"fastly_service_vcl.foo", "version_comment", versionComment2),
+					resource.TestCheckResourceAttr(
+						"fastly_service_vcl.foo", "active_version", "2"),
+				),
+			},
+		},
+	})
+}
+
+// ServiceVCL_import - test that a service imported by its ID alone is read
+// from its active version, with domains and backends matching the config.
+// ImportState steps don't persist the imported state, so whether a plan after
+// the import is empty isn't verified here.
+func TestAccFastlyServiceVCL_import(t *testing.T) {
+	var service gofastly.ServiceDetail
+	name := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	domainName := fmt.Sprintf("fastly-test.tf-%s.com", acctest.RandString(10))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckServiceVCLDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceVCLConfig(name, domainName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceVCLExists("fastly_service_vcl.foo", &service),
+					resource.TestCheckResourceAttr(
+						"fastly_service_vcl.foo", "domain.#", "1"),
+					resource.TestCheckResourceAttr(
+						"fastly_service_vcl.foo", "backend.#", "1"),
+				),
+			},
+			{
+				ResourceName:      "fastly_service_vcl.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// These attributes are not stored on the Fastly API and must be ignored.
+				ImportStateVerifyIgnore: []string{"activate", "force_destroy"},
+			},
+		},
+	})
+}
+
+// ServiceVCL_rename - test that changing the service name renames the
+// existing service in place rather than replacing it
+func TestAccFastlyServiceVCL_rename(t *testing.T) {
+	var service gofastly.ServiceDetail
+	var serviceID string
+	name1 := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	name2 := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	domainName := fmt.Sprintf("fastly-test.tf-%s.com", acctest.RandString(10))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckServiceVCLDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceVCLConfig(name1, domainName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceVCLExists("fastly_service_vcl.foo", &service),
+					resource.TestCheckResourceAttr(
+						"fastly_service_vcl.foo", "name", name1),
+					func(s *terraform.State) error {
+						serviceID = s.RootModule().Resources["fastly_service_vcl.foo"].Primary.ID
+						return nil
+					},
+				),
+			},
+			{
+				Config: testAccServiceVCLConfig(name2, domainName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceVCLExists("fastly_service_vcl.foo", &service),
+					resource.TestCheckResourceAttr(
+						"fastly_service_vcl.foo", "name", name2),
+					// the service is renamed in place so its ID must not change
+					resource.TestCheckResourceAttrPtr("fastly_service_vcl.foo", "id", &serviceID),
+					// renaming does not require a new service version
+					resource.TestCheckResourceAttr(
+						"fastly_service_vcl.foo", "active_version", "1"),
+					func(_ *terraform.State) error {
+						if service.Name != name2 {
+							return fmt.Errorf("bad name, expected (%s), got (%s)", name2, service.Name)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
 // ServiceVCL_disappears – test that a non-empty plan is returned when a Fastly
 // Service is destroyed outside of Terraform, and can no longer be found,
 // correctly clearing the ID field and generating a new plan
@@ -700,6 +1515,36 @@ func TestAccFastlyServiceVCL_defaultHost(t *testing.T) {
 	})
 }
 
+func TestAccFastlyServiceVCL_defaultHostAndTTL(t *testing.T) {
+	var service gofastly.ServiceDetail
+	name := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	domain := fmt.Sprintf("fastly-test.tf-%s.com", acctest.RandString(10))
+	defaultHost := fmt.Sprintf("%s.aws.amazon.com", acctest.RandString(3))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckServiceVCLDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceVCLConfig_defaultHostAndTTL(name, domain, defaultHost, 3400),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceVCLExists("fastly_service_vcl.foo", &service),
+					resource.TestCheckResourceAttr(
+						"fastly_service_vcl.foo", "default_host", defaultHost),
+					resource.TestCheckResourceAttr(
+						"fastly_service_vcl.foo", "default_ttl", "3400"),
+				),
+			},
+			// refreshing an unchanged config must not produce a diff
+			{
+				Config:   testAccServiceVCLConfig_defaultHostAndTTL(name, domain, defaultHost, 3400),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 // TestAccFastlyServiceVCL_brokenSnippet tests that a service can still be updated after it has failed during an apply.
 // This avoids a bug when activate=true, where setting an invalid snippet causes the resourceServiceUpdate function to
 // return early before activating the version. This broke the assumption that cloned_version always tracks the active
@@ -831,6 +1676,27 @@ resource "fastly_service_vcl" "foo" {
 }`, name, comment, domain, activate)
 }
 
+func testAccServiceVCLConfig_activateFalse(name, domain, versionComment string) string {
+	return fmt.Sprintf(`
+resource "fastly_service_vcl" "foo" {
+  name = "%s"
+  version_comment = "%s"
+
+  domain {
+    name    = "%s"
+    comment = "tf-testing-domain"
+  }
+
+  backend {
+    address = "aws.amazon.com"
+    name    = "amazon docs"
+  }
+
+  activate = false
+  force_destroy = true
+}`, name, versionComment, domain)
+}
+
 func testAccServiceVCLConfig_initWithVerstionComment(name, versionComment, domain string) string {
 	return fmt.Sprintf(`
 resource "fastly_service_vcl" "foo" {
@@ -872,6 +1738,28 @@ resource "fastly_service_vcl" "foo" {
 }`, name, domain, defaultHost)
 }
 
+func testAccServiceVCLConfig_defaultHostAndTTL(name, domain, defaultHost string, ttl int) string {
+	return fmt.Sprintf(`
+resource "fastly_service_vcl" "foo" {
+  name = "%s"
+
+  domain {
+    name    = "%s"
+    comment = "tf-testing-domain"
+  }
+
+  default_host = "%s"
+  default_ttl  = %d
+
+  backend {
+    address = "aws.amazon.com"
+    name    = "amazon docs"
+  }
+
+  force_destroy = true
+}`, name, domain, defaultHost, ttl)
+}
+
 func testAccServiceVCLConfig_basicUpdate(name, comment, versionComment, domain string) string {
 	return fmt.Sprintf(`
 resource "fastly_service_vcl" "foo" {
@@ -984,6 +1872,85 @@ resource "fastly_service_vcl" "foo" {
 }`, name, domain, backend)
 }
 
+func testAccCheckFastlyServiceVCLBackendOverrideHost(service *gofastly.ServiceDetail, expected string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*FastlyClient).conn
+		b, err := conn.GetBackend(&gofastly.GetBackendInput{
+			ServiceID:      service.ID,
+			ServiceVersion: service.ActiveVersion.Number,
+			Name:           "tf-test-backend",
+		})
+		if err != nil {
+			return fmt.Errorf("[ERR] Error looking up Backend for (%s), version (%v): %s", service.Name, service.ActiveVersion.Number, err)
+		}
+		if b.OverrideHost != expected {
+			return fmt.Errorf("Bad override_host, expected (%q), got (%q)", expected, b.OverrideHost)
+		}
+		return nil
+	}
+}
+
+func testAccServiceVCLConfig_backendOverrideHost(name, domain, overrideHost string) string {
+	return fmt.Sprintf(`
+resource "fastly_service_vcl" "foo" {
+  name = "%s"
+
+  domain {
+    name    = "%s"
+    comment = "tf-testing-domain"
+  }
+
+  backend {
+    address       = "aws.amazon.com"
+    name          = "tf-test-backend"
+    override_host = "%s"
+  }
+
+  force_destroy = true
+}`, name, domain, overrideHost)
+}
+
+func testAccCheckFastlyServiceVCLBackendSSLSNIHostname(service *gofastly.ServiceDetail, expected string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*FastlyClient).conn
+		b, err := conn.GetBackend(&gofastly.GetBackendInput{
+			ServiceID:      service.ID,
+			ServiceVersion: service.ActiveVersion.Number,
+			Name:           "tf-test-backend",
+		})
+		if err != nil {
+			return fmt.Errorf("[ERR] Error looking up Backend for (%s), version (%v): %s", service.Name, service.ActiveVersion.Number, err)
+		}
+		if b.SSLSNIHostname != expected {
+			return fmt.Errorf("Bad ssl_sni_hostname, expected (%q), got (%q)", expected, b.SSLSNIHostname)
+		}
+		return nil
+	}
+}
+
+func testAccServiceVCLConfig_backendSSLSNIHostname(name, domain, sniHostname string) string {
+	return fmt.Sprintf(`
+resource "fastly_service_vcl" "foo" {
+  name = "%s"
+
+  domain {
+    name    = "%s"
+    comment = "tf-testing-domain"
+  }
+
+  backend {
+    address           = "aws.amazon.com"
+    name              = "tf-test-backend"
+    port              = 443
+    use_ssl           = true
+    ssl_cert_hostname = "aws.amazon.com"
+    ssl_sni_hostname  = "%s"
+  }
+
+  force_destroy = true
+}`, name, domain, sniHostname)
+}
+
 func testAccServiceVCLConfig_staticBackend(name, domain, snippet string) string {
 	return fmt.Sprintf(`
 resource "fastly_service_vcl" "foo" {
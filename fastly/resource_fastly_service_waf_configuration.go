@@ -172,10 +172,11 @@ func resourceServiceWAFConfiguration() *schema.Resource {
 				Description: "Score value to add for notice anomalies",
 			},
 			"paranoia_level": {
-				Type:        schema.TypeInt,
-				Optional:    true,
-				Computed:    true,
-				Description: "The configured paranoia level",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				Description:  "The configured paranoia level, from `1` to `4`. Higher levels enable more rules, and catch more attacks at the cost of more false positives",
+				ValidateFunc: validation.IntBetween(1, 4),
 			},
 			"php_injection_score_threshold": {
 				Type:         schema.TypeInt,
@@ -23,7 +23,7 @@ func resourceFastlyTLSActivation() *schema.Resource {
 			"certificate_id": {
 				Type:        schema.TypeString,
 				Required:    true,
-				Description: "ID of certificate to use. Must have the `domain` specified in the certificate's Subject Alternative Names.",
+				Description: "ID of certificate to use. Must have the `domain` specified in the certificate's Subject Alternative Names. Changing this updates the activation in place, so the domain keeps serving TLS while the certificate is rotated.",
 			},
 			"configuration_id": {
 				Type:        schema.TypeString,
@@ -43,6 +43,16 @@ func resourceFastlyTLSActivation() *schema.Resource {
 				Computed:    true,
 				Description: "Time-stamp (GMT) when TLS was enabled.",
 			},
+			"not_after": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Time-stamp (GMT) when the activated certificate will expire.",
+			},
+			"not_before": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Time-stamp (GMT) when the activated certificate will become valid.",
+			},
 		},
 	}
 }
@@ -72,8 +82,10 @@ func resourceFastlyTLSActivationCreate(ctx context.Context, d *schema.ResourceDa
 func resourceFastlyTLSActivationRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*FastlyClient).conn
 
+	include := "tls_certificate"
 	activation, err := conn.GetTLSActivation(&fastly.GetTLSActivationInput{
-		ID: d.Id(),
+		ID:      d.Id(),
+		Include: &include,
 	})
 	if err != nil {
 		return diag.FromErr(err)
@@ -96,18 +108,39 @@ func resourceFastlyTLSActivationRead(_ context.Context, d *schema.ResourceData,
 		return diag.FromErr(err)
 	}
 
+	// The certificate's validity is only included when it is returned alongside the activation.
+	var notAfter, notBefore string
+	if activation.Certificate.NotAfter != nil {
+		notAfter = activation.Certificate.NotAfter.Format(time.RFC3339)
+	}
+	if activation.Certificate.NotBefore != nil {
+		notBefore = activation.Certificate.NotBefore.Format(time.RFC3339)
+	}
+	err = d.Set("not_after", notAfter)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = d.Set("not_before", notBefore)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	return nil
 }
 
 func resourceFastlyTLSActivationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*FastlyClient).conn
 
-	_, err := conn.UpdateTLSActivation(&fastly.UpdateTLSActivationInput{
-		ID:          d.Id(),
-		Certificate: &fastly.CustomTLSCertificate{ID: d.Get("certificate_id").(string)},
-	})
-	if err != nil {
-		return diag.FromErr(err)
+	// Switching the certificate of an existing activation, rather than deleting and re-creating it, avoids a window
+	// where the domain has no certificate.
+	if d.HasChange("certificate_id") {
+		_, err := conn.UpdateTLSActivation(&fastly.UpdateTLSActivationInput{
+			ID:          d.Id(),
+			Certificate: &fastly.CustomTLSCertificate{ID: d.Get("certificate_id").(string)},
+		})
+		if err != nil {
+			return diag.FromErr(err)
+		}
 	}
 
 	return resourceFastlyTLSActivationRead(ctx, d, meta)
@@ -42,6 +42,8 @@ func TestAccFastlyTLSActivation_basic(t *testing.T) {
 					resource.TestCheckResourceAttrSet(resourceName, "configuration_id"),
 					resource.TestCheckResourceAttr(resourceName, "domain", domain),
 					resource.TestCheckResourceAttrSet(resourceName, "created_at"),
+					resource.TestCheckResourceAttrSet(resourceName, "not_after"),
+					resource.TestCheckResourceAttrSet(resourceName, "not_before"),
 					testAccFastlyTLSActivationCheckExists(resourceName),
 				),
 			},
@@ -58,6 +60,51 @@ func TestAccFastlyTLSActivation_basic(t *testing.T) {
 	})
 }
 
+func TestAccFastlyTLSActivation_rotateCertificate(t *testing.T) {
+	domain := fmt.Sprintf("%s.com", acctest.RandomWithPrefix(testResourcePrefix))
+	key, cert, cert2, err := generateKeyAndMultipleCerts(domain)
+	require.NoError(t, err)
+	key = strings.ReplaceAll(key, "\n", `\n`)
+	cert = strings.ReplaceAll(cert, "\n", `\n`)
+	cert2 = strings.ReplaceAll(cert2, "\n", `\n`)
+
+	name := acctest.RandomWithPrefix(testResourcePrefix)
+
+	var activationID string
+	resourceName := "fastly_tls_activation.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccFastlyTLSActivationCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFastlyTLSActivationRotateConfig(name, key, cert, cert2, domain, "old"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "certificate_id", "fastly_tls_certificate.old", "id"),
+					testAccFastlyTLSActivationCheckExists(resourceName),
+					func(state *terraform.State) error {
+						activationID = state.RootModule().Resources[resourceName].Primary.ID
+						return nil
+					},
+				),
+			},
+			{
+				Config: testAccFastlyTLSActivationRotateConfig(name, key, cert, cert2, domain, "new"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "certificate_id", "fastly_tls_certificate.new", "id"),
+					testAccFastlyTLSActivationCheckExists(resourceName),
+					func(state *terraform.State) error {
+						if id := state.RootModule().Resources[resourceName].Primary.ID; id != activationID {
+							return fmt.Errorf("expected TLS activation (%s) to be updated in place, got (%s)", activationID, id)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
 func testAccFastlyTLSActivationConfig(serviceName, keyName, key, certName, cert, domain string) string {
 	return fmt.Sprintf(`
 resource "fastly_service_vcl" "test" {
@@ -94,6 +141,48 @@ resource "fastly_tls_activation" "test" {
 `, serviceName, domain, key, keyName, cert, certName, domain)
 }
 
+func testAccFastlyTLSActivationRotateConfig(name, key, oldCert, newCert, domain, activeCert string) string {
+	return fmt.Sprintf(`
+resource "fastly_service_vcl" "test" {
+  name = "%[1]s"
+
+  domain {
+    name = "%[5]s"
+  }
+
+  backend {
+    address = "127.0.0.1"
+    name    = "localhost"
+  }
+
+  force_destroy = true
+}
+
+resource "fastly_tls_private_key" "test" {
+  key_pem = "%[2]s"
+  name = "%[1]s"
+}
+
+resource "fastly_tls_certificate" "old" {
+  certificate_body = "%[3]s"
+  name = "%[1]s-old"
+  depends_on = [fastly_tls_private_key.test]
+}
+
+resource "fastly_tls_certificate" "new" {
+  certificate_body = "%[4]s"
+  name = "%[1]s-new"
+  depends_on = [fastly_tls_private_key.test]
+}
+
+resource "fastly_tls_activation" "test" {
+  certificate_id = fastly_tls_certificate.%[6]s.id
+  domain = "%[5]s"
+  depends_on = [fastly_service_vcl.test]
+}
+`, name, key, oldCert, newCert, domain, activeCert)
+}
+
 func testAccFastlyTLSActivationCheckExists(resourceName string) resource.TestCheckFunc {
 	return func(state *terraform.State) error {
 		conn := testAccProvider.Meta().(*FastlyClient).conn
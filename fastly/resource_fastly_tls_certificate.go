@@ -2,11 +2,15 @@ package fastly
 
 import (
 	"context"
+	"crypto/x509"
+	"encoding/pem"
 	"fmt"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"log"
+	"strings"
 	"time"
 
 	"github.com/fastly/go-fastly/v6/fastly"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -29,7 +33,7 @@ func resourceFastlyTLSCertificate() *schema.Resource {
 			},
 			"certificate_body": {
 				Type:             schema.TypeString,
-				Description:      "PEM-formatted certificate, optionally including any intermediary certificates.",
+				Description:      "PEM-formatted certificate, optionally including any intermediary certificates. Changing it replaces the certificate in place. If the certificate is replaced outside of Terraform, the next apply uploads this one again.",
 				Required:         true,
 				ValidateDiagFunc: validatePEMBlocks("CERTIFICATE"),
 			},
@@ -53,6 +57,16 @@ func resourceFastlyTLSCertificate() *schema.Resource {
 				Description: "The certificate authority that issued the certificate.",
 				Computed:    true,
 			},
+			"not_after": {
+				Type:        schema.TypeString,
+				Description: "Timestamp (GMT) when the certificate will expire.",
+				Computed:    true,
+			},
+			"not_before": {
+				Type:        schema.TypeString,
+				Description: "Timestamp (GMT) when the certificate will become valid.",
+				Computed:    true,
+			},
 			"replace": {
 				Type:        schema.TypeBool,
 				Description: "A recommendation from Fastly indicating the key associated with this certificate is in need of rotation.",
@@ -107,13 +121,21 @@ func resourceFastlyTLSCertificateRead(_ context.Context, d *schema.ResourceData,
 	cert, err := conn.GetCustomTLSCertificate(&fastly.GetCustomTLSCertificateInput{
 		ID: d.Id(),
 	})
-	if err != nil {
+	if err, ok := err.(*fastly.HTTPError); ok && err.IsNotFound() {
+		log.Printf("[WARN] TLS certificate (%s) not found - removing from state", d.Id())
+		d.SetId("")
+		return nil
+	} else if err != nil {
 		return diag.FromErr(err)
 	}
 
-	var domains []string
-	for _, domain := range cert.Domains {
-		domains = append(domains, domain.ID)
+	// The API doesn't return the certificate itself, so a certificate replaced outside of Terraform is detected by its
+	// serial number. Clearing the body produces a diff that uploads the configured certificate again.
+	if body := d.Get("certificate_body").(string); body != "" && !certificateHasSerialNumber(body, cert.SerialNumber) {
+		log.Printf("[WARN] TLS certificate (%s) has been replaced outside of Terraform", d.Id())
+		if err := d.Set("certificate_body", ""); err != nil {
+			return diag.FromErr(err)
+		}
 	}
 
 	if cert.Replace {
@@ -138,6 +160,12 @@ func resourceFastlyTLSCertificateRead(_ context.Context, d *schema.ResourceData,
 	if err := d.Set("issuer", cert.Issuer); err != nil {
 		return diag.FromErr(err)
 	}
+	if err := d.Set("not_after", cert.NotAfter.Format(time.RFC3339)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("not_before", cert.NotBefore.Format(time.RFC3339)); err != nil {
+		return diag.FromErr(err)
+	}
 	if err := d.Set("replace", cert.Replace); err != nil {
 		return diag.FromErr(err)
 	}
@@ -147,7 +175,7 @@ func resourceFastlyTLSCertificateRead(_ context.Context, d *schema.ResourceData,
 	if err := d.Set("signature_algorithm", cert.SignatureAlgorithm); err != nil {
 		return diag.FromErr(err)
 	}
-	if err := d.Set("domains", domains); err != nil {
+	if err := d.Set("domains", flattenTLSCertificateDomains(cert.Domains)); err != nil {
 		return diag.FromErr(err)
 	}
 
@@ -187,3 +215,27 @@ func resourceFastlyTLSCertificateDelete(_ context.Context, d *schema.ResourceDat
 
 	return nil
 }
+
+// flattenTLSCertificateDomains returns the names of the domains covered by a certificate.
+func flattenTLSCertificateDomains(domains []*fastly.TLSDomain) []string {
+	var names []string
+	for _, domain := range domains {
+		names = append(names, domain.ID)
+	}
+	return names
+}
+
+// certificateHasSerialNumber reports whether the first certificate in a PEM body has the given serial number, in
+// either decimal or hexadecimal form. A body that can't be parsed is assumed to match, so that it never causes a diff
+// on its own.
+func certificateHasSerialNumber(body, serialNumber string) bool {
+	block, _ := pem.Decode([]byte(body))
+	if block == nil {
+		return true
+	}
+	c, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return true
+	}
+	return serialNumber == c.SerialNumber.String() || strings.EqualFold(serialNumber, c.SerialNumber.Text(16))
+}
@@ -1,6 +1,8 @@
 package fastly
 
 import (
+	"crypto/x509"
+	"encoding/pem"
 	"fmt"
 	"strings"
 	"testing"
@@ -20,6 +22,28 @@ func init() {
 	})
 }
 
+func TestResourceFastlyFlattenTLSCertificateDomains(t *testing.T) {
+	domains := flattenTLSCertificateDomains([]*fastly.TLSDomain{{ID: "example.com"}, {ID: "*.example.com"}})
+	require.Equal(t, []string{"example.com", "*.example.com"}, domains)
+	require.Nil(t, flattenTLSCertificateDomains(nil))
+}
+
+func TestCertificateHasSerialNumber(t *testing.T) {
+	privateKey, _, err := buildPrivateKey()
+	require.NoError(t, err)
+	cert, err := buildCertificate(privateKey, "example.com")
+	require.NoError(t, err)
+
+	block, _ := pem.Decode([]byte(cert))
+	parsed, err := x509.ParseCertificate(block.Bytes)
+	require.NoError(t, err)
+
+	require.True(t, certificateHasSerialNumber(cert, parsed.SerialNumber.String()))
+	require.True(t, certificateHasSerialNumber(cert, strings.ToUpper(parsed.SerialNumber.Text(16))))
+	require.False(t, certificateHasSerialNumber(cert, "1"))
+	require.True(t, certificateHasSerialNumber("not a certificate", "1"))
+}
+
 func TestAccFastlyTLSCertificate_withName(t *testing.T) {
 	name := acctest.RandomWithPrefix(testResourcePrefix)
 	updatedName := acctest.RandomWithPrefix(testResourcePrefix)
@@ -39,6 +63,8 @@ func TestAccFastlyTLSCertificate_withName(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr(resourceName, "name", name),
 					resource.TestCheckResourceAttrSet(resourceName, "created_at"),
+					resource.TestCheckResourceAttrSet(resourceName, "not_after"),
+					resource.TestCheckResourceAttrSet(resourceName, "not_before"),
 					resource.TestCheckResourceAttrSet(resourceName, "updated_at"),
 					resource.TestCheckResourceAttr(resourceName, "issued_to", domain),
 					resource.TestCheckResourceAttrSet(resourceName, "issuer"),
@@ -51,7 +77,11 @@ func TestAccFastlyTLSCertificate_withName(t *testing.T) {
 			},
 			{
 				Config: testAccTLSCertificateWithName(name, key, updatedName, cert2),
-				Check:  resource.TestCheckResourceAttr(resourceName, "name", updatedName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", updatedName),
+					resource.TestCheckResourceAttr(resourceName, "certificate_body", cert2+"\n"),
+					testAccTLSCertificateExists(resourceName),
+				),
 			},
 			{
 				ResourceName:            resourceName,
@@ -224,28 +224,9 @@ func resourceFastlyTLSSubscriptionRead(_ context.Context, d *schema.ResourceData
 		certificateId = subscription.Certificates[0].ID
 	}
 
-	var managedHTTPChallenges []map[string]interface{}
-	var managedDNSChallenges []map[string]interface{}
-	for _, domain := range subscription.Authorizations {
-		for _, challenge := range domain.Challenges {
-			if challenge.Type == "managed-dns" {
-				if len(challenge.Values) < 1 {
-					return diag.Errorf("Fastly API returned no record values for Managed DNS Challenges")
-				}
-
-				managedDNSChallenges = append(managedDNSChallenges, map[string]interface{}{
-					"record_type":  challenge.RecordType,
-					"record_name":  challenge.RecordName,
-					"record_value": challenge.Values[0],
-				})
-			} else {
-				managedHTTPChallenges = append(managedHTTPChallenges, map[string]interface{}{
-					"record_type":   challenge.RecordType,
-					"record_name":   challenge.RecordName,
-					"record_values": challenge.Values,
-				})
-			}
-		}
+	managedDNSChallenges, managedHTTPChallenges, err := flattenTLSSubscriptionChallenges(subscription.Authorizations)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	// TODO: This block of code contains a bug where the state file will only include
@@ -255,7 +236,12 @@ func resourceFastlyTLSSubscriptionRead(_ context.Context, d *schema.ResourceData
 	// https://github.com/fastly/terraform-provider-fastly/pull/435
 	{
 		var managedDNSChallengeOld map[string]string
-		for _, challenge := range subscription.Authorizations[0].Challenges {
+		var challenges []gofastly.TLSChallenge
+		// A subscription that has just been created may not include its authorizations yet.
+		if len(subscription.Authorizations) > 0 {
+			challenges = subscription.Authorizations[0].Challenges
+		}
+		for _, challenge := range challenges {
 			if challenge.Type == "managed-dns" {
 				if len(challenge.Values) < 1 {
 					return diag.Errorf("Fastly API returned no record values for Managed DNS Challenge")
@@ -356,9 +342,40 @@ func resourceFastlyTLSSubscriptionDelete(_ context.Context, d *schema.ResourceDa
 		ID:    d.Id(),
 		Force: d.Get("force_destroy").(bool),
 	})
+	// A pending subscription whose domain validation was abandoned may already have been removed by Fastly.
+	if err, ok := err.(*gofastly.HTTPError); ok && err.IsNotFound() {
+		return nil
+	}
 	return diag.FromErr(err)
 }
 
+// flattenTLSSubscriptionChallenges converts the challenges of a subscription's authorizations into the
+// managed_dns_challenges and managed_http_challenges attributes.
+func flattenTLSSubscriptionChallenges(authorizations []*gofastly.TLSAuthorizations) (managedDNSChallenges, managedHTTPChallenges []map[string]interface{}, err error) {
+	for _, domain := range authorizations {
+		for _, challenge := range domain.Challenges {
+			if challenge.Type == "managed-dns" {
+				if len(challenge.Values) < 1 {
+					return nil, nil, fmt.Errorf("Fastly API returned no record values for Managed DNS Challenges")
+				}
+
+				managedDNSChallenges = append(managedDNSChallenges, map[string]interface{}{
+					"record_type":  challenge.RecordType,
+					"record_name":  challenge.RecordName,
+					"record_value": challenge.Values[0],
+				})
+			} else {
+				managedHTTPChallenges = append(managedHTTPChallenges, map[string]interface{}{
+					"record_type":   challenge.RecordType,
+					"record_name":   challenge.RecordName,
+					"record_values": challenge.Values,
+				})
+			}
+		}
+	}
+	return managedDNSChallenges, managedHTTPChallenges, nil
+}
+
 func resourceFastlyTLSSubscriptionIsStateImmutable(_ context.Context, d *schema.ResourceDiff, _ interface{}) bool {
 	state := d.Get("state").(string)
 	return state != "issued" && state != "pending"
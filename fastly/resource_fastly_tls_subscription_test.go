@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	"github.com/fastly/go-fastly/v6/fastly"
+	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -42,6 +43,7 @@ func TestAccResourceFastlyTLSSubscription(t *testing.T) {
 					resource.TestCheckResourceAttrSet(resourceName, "updated_at"),
 					resource.TestCheckResourceAttrSet(resourceName, "state"),
 					resource.TestCheckResourceAttr(resourceName, "managed_dns_challenge.%", "3"),
+					resource.TestCheckResourceAttr(resourceName, "managed_dns_challenges.#", "2"),
 					resource.TestCheckResourceAttrSet(resourceName, "managed_http_challenges.#"),
 					resource.TestCheckResourceAttr(resourceName, "common_name", domain1),
 					testAccResourceFastlyTLSSubscriptionExists(resourceName, &subscriptionId),
@@ -73,6 +75,43 @@ func TestAccResourceFastlyTLSSubscription(t *testing.T) {
 	})
 }
 
+func TestResourceFastlyFlattenTLSSubscriptionChallenges(t *testing.T) {
+	dns, http, err := flattenTLSSubscriptionChallenges([]*fastly.TLSAuthorizations{
+		{
+			Challenges: []fastly.TLSChallenge{
+				{Type: "managed-dns", RecordType: "CNAME", RecordName: "_acme-challenge.example.com", Values: []string{"xxxxx.fastly-validations.com"}},
+				{Type: "managed-http-cname", RecordType: "CNAME", RecordName: "example.com", Values: []string{"j.sni.global.fastly.net"}},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if diff := cmp.Diff([]map[string]interface{}{
+		{"record_type": "CNAME", "record_name": "_acme-challenge.example.com", "record_value": "xxxxx.fastly-validations.com"},
+	}, dns); diff != "" {
+		t.Fatalf("Error matching: %s", diff)
+	}
+	if diff := cmp.Diff([]map[string]interface{}{
+		{"record_type": "CNAME", "record_name": "example.com", "record_values": []string{"j.sni.global.fastly.net"}},
+	}, http); diff != "" {
+		t.Fatalf("Error matching: %s", diff)
+	}
+
+	// A subscription that has just been created may not include its authorizations yet.
+	dns, http, err = flattenTLSSubscriptionChallenges(nil)
+	if err != nil || dns != nil || http != nil {
+		t.Fatalf("expected no challenges, got %v, %v, %v", dns, http, err)
+	}
+
+	_, _, err = flattenTLSSubscriptionChallenges([]*fastly.TLSAuthorizations{
+		{Challenges: []fastly.TLSChallenge{{Type: "managed-dns", RecordType: "CNAME", RecordName: "_acme-challenge.example.com"}}},
+	})
+	if err == nil {
+		t.Fatal("expected an error for a DNS challenge without record values")
+	}
+}
+
 func testAccResourceFastlyTLSSubscriptionConfig(name, domain1, domain2, commonName string) string {
 	return fmt.Sprintf(`
 resource "fastly_service_vcl" "test" {
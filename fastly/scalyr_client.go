@@ -0,0 +1,65 @@
+package fastly
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+
+	gofastly "github.com/fastly/go-fastly/v6/fastly"
+	"github.com/mitchellh/mapstructure"
+)
+
+// This file holds a minimal client for the project_id attribute of the Scalyr logging API, which go-fastly v6 doesn't
+// cover yet. Like the New Relic OTLP client, it goes through the go-fastly client, and should be replaced by the
+// go-fastly equivalents once the dependency is upgraded.
+
+// scalyrProjectID models the project_id attribute of the Scalyr logging API, which is not yet covered by go-fastly.
+type scalyrProjectID struct {
+	Name      string `mapstructure:"name"`
+	ProjectID string `mapstructure:"project_id"`
+}
+
+type updateScalyrProjectIDInput struct {
+	ProjectID string `url:"project_id"`
+}
+
+func scalyrPath(serviceID string, serviceVersion int) string {
+	return fmt.Sprintf("/service/%s/version/%d/logging/scalyr", serviceID, serviceVersion)
+}
+
+// listScalyrProjectIDs returns the project_id of each Scalyr logging endpoint, keyed by the endpoint name.
+func listScalyrProjectIDs(conn *gofastly.Client, serviceID string, serviceVersion int) (map[string]string, error) {
+	resp, err := conn.Get(scalyrPath(serviceID, serviceVersion), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var parsed interface{}
+	if err := json.NewDecoder(resp.Body).Decode(&parsed); err != nil {
+		return nil, err
+	}
+	return decodeScalyrProjectIDs(parsed)
+}
+
+func decodeScalyrProjectIDs(in interface{}) (map[string]string, error) {
+	var s []*scalyrProjectID
+	if err := mapstructure.Decode(in, &s); err != nil {
+		return nil, err
+	}
+
+	projectIDs := make(map[string]string, len(s))
+	for _, p := range s {
+		projectIDs[p.Name] = p.ProjectID
+	}
+	return projectIDs, nil
+}
+
+func updateScalyrProjectID(conn *gofastly.Client, serviceID string, serviceVersion int, name, projectID string) error {
+	path := fmt.Sprintf("%s/%s", scalyrPath(serviceID, serviceVersion), url.PathEscape(name))
+	resp, err := conn.PutForm(path, &updateScalyrProjectIDInput{ProjectID: projectID}, nil)
+	if err != nil {
+		return err
+	}
+	return resp.Body.Close()
+}
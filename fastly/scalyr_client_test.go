@@ -0,0 +1,89 @@
+package fastly
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestListScalyrProjectIDs(t *testing.T) {
+	conn := testFastlyClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/service/123/version/2/logging/scalyr" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`[
+			{"name": "scalyr-endpoint", "project_id": "fastly-logs", "format_version": "2"},
+			{"name": "another-scalyr-endpoint", "project_id": "logplex"}
+		]`))
+	})
+
+	out, err := listScalyrProjectIDs(conn, "123", 2)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := map[string]string{"scalyr-endpoint": "fastly-logs", "another-scalyr-endpoint": "logplex"}
+	if diff := cmp.Diff(expected, out); diff != "" {
+		t.Fatalf("Error matching: %s", diff)
+	}
+}
+
+func TestListScalyrProjectIDsError(t *testing.T) {
+	conn := testFastlyClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		_, _ = w.Write([]byte(`{"msg": "Provided credentials are missing or invalid"}`))
+	})
+
+	if _, err := listScalyrProjectIDs(conn, "123", 2); err == nil {
+		t.Fatal("expected an error, got none")
+	}
+}
+
+func TestUpdateScalyrProjectID(t *testing.T) {
+	conn := testFastlyClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut || r.URL.EscapedPath() != "/service/123/version/2/logging/scalyr/scalyr%20endpoint" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.EscapedPath())
+		}
+		if err := r.ParseForm(); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if got := r.PostForm.Get("project_id"); got != "fastly-logs" {
+			t.Errorf("expected project_id to be %q, got %q", "fastly-logs", got)
+		}
+		_, _ = w.Write([]byte(`{}`))
+	})
+
+	if err := updateScalyrProjectID(conn, "123", 2, "scalyr endpoint", "fastly-logs"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+}
+
+func TestResourceFastlyDecodeScalyrProjectIDs(t *testing.T) {
+	in := []interface{}{
+		map[string]interface{}{
+			"name":           "scalyr-endpoint",
+			"project_id":     "fastly-logs",
+			"format_version": "2",
+			"created_at":     "2021-01-01T00:00:00Z",
+		},
+		map[string]interface{}{
+			"name":       "another-scalyr-endpoint",
+			"project_id": "logplex",
+		},
+	}
+
+	out, err := decodeScalyrProjectIDs(in)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := map[string]string{
+		"scalyr-endpoint":         "fastly-logs",
+		"another-scalyr-endpoint": "logplex",
+	}
+	if diff := cmp.Diff(expected, out); diff != "" {
+		t.Fatalf("Error matching: %s", diff)
+	}
+}
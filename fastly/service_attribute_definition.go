@@ -2,8 +2,13 @@ package fastly
 
 import (
 	"context"
+	"fmt"
+	"sort"
+	"strings"
 
 	gofastly "github.com/fastly/go-fastly/v6/fastly"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -72,7 +77,7 @@ func (h *DefaultServiceAttributeHandler) getVCLLoggingAttributes(data map[string
 	}
 	if h.GetServiceMetadata().serviceType == ServiceTypeVCL {
 		if val, ok := data["format"]; ok {
-			vla.format = val.(string)
+			vla.format = loggingFormat(data, val.(string))
 		}
 		if val, ok := data["format_version"]; ok {
 			vla.formatVersion = gofastly.Uint(uint(val.(int)))
@@ -98,3 +103,200 @@ func (h *DefaultServiceAttributeHandler) pruneVCLLoggingAttributes(data map[stri
 	}
 	return data
 }
+
+// checkLoggingCompression returns an error if an object-store logging endpoint sets both compression_codec and a
+// non-zero gzip_level, which the API rejects.
+func checkLoggingCompression(resource map[string]interface{}) error {
+	codec, _ := resource["compression_codec"].(string)
+	gzipLevel, _ := resource["gzip_level"].(int)
+	if codec != "" && gzipLevel != 0 {
+		return fmt.Errorf("[ERR] Only one of compression_codec and gzip_level can be set for logging endpoint (%s)", resource["name"])
+	}
+	return nil
+}
+
+// pruneLoggingCompression deletes the gzip_level read back from the API when compression_codec is set. The API fills
+// in a gzip_level of 3 for the "gzip" codec, which would otherwise diff against a config that only sets the codec.
+func pruneLoggingCompression(data map[string]interface{}) {
+	if codec, _ := data["compression_codec"].(string); codec != "" {
+		delete(data, "gzip_level")
+	}
+}
+
+// defaultLoggingPlacementKey is the VCL service attribute holding the placement of logging endpoints that don't set
+// their own.
+const defaultLoggingPlacementKey = "default_logging_placement"
+
+// restoreLoggingPlacement removes the placement read back from the API for a VCL logging endpoint when it is the
+// service's default_logging_placement and the endpoint doesn't set its own, so that it doesn't diff against the config.
+func (h *DefaultServiceAttributeHandler) restoreLoggingPlacement(d *schema.ResourceData, data map[string]interface{}) {
+	if h.GetServiceMetadata().serviceType != ServiceTypeVCL {
+		return
+	}
+
+	defaultPlacement, _ := d.Get(defaultLoggingPlacementKey).(string)
+	if defaultPlacement == "" || data["placement"] != defaultPlacement {
+		return
+	}
+	if set, ok := d.Get(h.GetKey()).(*schema.Set); ok {
+		for _, elem := range set.List() {
+			prior := elem.(map[string]interface{})
+			if prior["name"] == data["name"] && prior["placement"] == "" {
+				delete(data, "placement")
+				break
+			}
+		}
+	}
+}
+
+// validateLoggingEndpoints returns a CustomizeDiffFunc running check against every logging endpoint of the block key,
+// so that an invalid endpoint fails the plan rather than the apply, once a new version has already been cloned. Values
+// that aren't known until apply are seen by check as placeholder strings, so they count as set.
+func validateLoggingEndpoints(key string, check func(resource map[string]interface{}) error) schema.CustomizeDiffFunc {
+	return func(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+		for _, elem := range d.Get(key).(*schema.Set).List() {
+			if err := check(elem.(map[string]interface{})); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+// loggingPlacementWarnings returns a warning for every VCL logging endpoint placed in `waf_debug`, directly or through
+// default_logging_placement, when the service has no waf. The waf_debug logging is only called for requests inspected
+// by a WAF, so such an endpoint never logs anything. Only endpoints that changed, or all of them if waf or
+// default_logging_placement changed, are checked, so the warning isn't repeated on every apply.
+func loggingPlacementWarnings(d *schema.ResourceData, serviceDef ServiceDefinition) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if wafs, _ := d.Get("waf").([]interface{}); len(wafs) > 0 {
+		return diags
+	}
+	all := d.HasChanges("waf", defaultLoggingPlacementKey)
+	defaultPlacement, _ := d.Get(defaultLoggingPlacementKey).(string)
+
+	var keys []string
+	for key, s := range blockSchemas(serviceDef) {
+		if elem, ok := s.Elem.(*schema.Resource); ok && elem.Schema["placement"] != nil && (all || d.HasChange(key)) {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		set, ok := d.Get(key).(*schema.Set)
+		if !ok {
+			continue
+		}
+		var names []string
+		for _, elem := range set.List() {
+			m := elem.(map[string]interface{})
+			placement, _ := m["placement"].(string)
+			if placement == "" {
+				placement = defaultPlacement
+			}
+			if placement == "waf_debug" {
+				names = append(names, m["name"].(string))
+			}
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  "Logging endpoint placed in waf_debug without a WAF",
+				Detail:   fmt.Sprintf("%s '%s' is placed in waf_debug, but the service has no waf, so it never logs anything. Configure a waf or use another placement", key, name),
+			})
+		}
+	}
+
+	return diags
+}
+
+// loggingDefaultFormat is the format Fastly gives a logging endpoint created without one.
+const loggingDefaultFormat = `%h %l %u %t "%r" %>s %b`
+
+// loggingAppendNewlineDescription documents the append_newline attribute of VCL logging endpoints.
+const loggingAppendNewlineDescription = "Whether to ensure `format` ends with a newline, so that consecutive log lines aren't concatenated. Default `true`"
+
+// loggingFormat returns the format to send to the API for a logging endpoint, with a trailing newline appended when
+// the endpoint's append_newline attribute requires it.
+func loggingFormat(data map[string]interface{}, format string) string {
+	if appendNewline, ok := data["append_newline"].(bool); ok && appendNewline && format != "" && !strings.HasSuffix(format, "\n") {
+		return format + "\n"
+	}
+	return format
+}
+
+// modifiedLoggingFormat returns the format to send to the API when updating a logging endpoint, or nil if neither
+// format nor append_newline were modified.
+func (h *DefaultServiceAttributeHandler) modifiedLoggingFormat(resource, modified map[string]interface{}) *string {
+	_, formatModified := modified["format"]
+	_, appendNewlineModified := modified["append_newline"]
+	if !formatModified && !appendNewlineModified {
+		return nil
+	}
+	format, _ := resource["format"].(string)
+	return gofastly.String(loggingFormat(resource, format))
+}
+
+// restoreLoggingFormat sets append_newline, which isn't stored by the API, on a flattened logging endpoint from the
+// matching endpoint in state, and reverts format to the configured value if the only difference is the newline the
+// provider appended, or if format isn't set and the API filled in its default. When state has no append_newline for
+// the endpoint, e.g. on import or after upgrading from a provider version without the attribute, it is inferred from
+// whether the remote format already ends with a newline, so the upgrade doesn't change the format sent to Fastly
+// without showing up in the plan.
+func (h *DefaultServiceAttributeHandler) restoreLoggingFormat(d *schema.ResourceData, data map[string]interface{}) {
+	if h.GetServiceMetadata().serviceType != ServiceTypeVCL {
+		return
+	}
+
+	remoteFormat, hasFormat := data["format"].(string)
+	appendNewline := strings.HasSuffix(remoteFormat, "\n")
+	if set, ok := d.Get(h.GetKey()).(*schema.Set); ok {
+		for _, elem := range set.List() {
+			prior := elem.(map[string]interface{})
+			if prior["name"] != data["name"] {
+				continue
+			}
+			if v, ok := prior["append_newline"].(bool); ok && !loggingAppendNewlineUnset(d.GetRawState(), h.GetKey(), data["name"]) {
+				appendNewline = v
+			}
+			priorFormat, _ := prior["format"].(string)
+			if hasFormat {
+				switch {
+				case priorFormat == "" && strings.TrimSuffix(remoteFormat, "\n") == loggingDefaultFormat:
+					delete(data, "format")
+				case appendNewline && priorFormat+"\n" == remoteFormat:
+					data["format"] = priorFormat
+				}
+			}
+			break
+		}
+	}
+	data["append_newline"] = appendNewline
+}
+
+// loggingAppendNewlineUnset reports whether the raw state holds the named endpoint of the given logging block without
+// an append_newline value, which is the case for state written before the attribute existed.
+func loggingAppendNewlineUnset(state cty.Value, key string, name interface{}) bool {
+	if state.IsNull() || !state.IsKnown() || !state.Type().IsObjectType() || !state.Type().HasAttribute(key) {
+		return false
+	}
+	set := state.GetAttr(key)
+	if set.IsNull() || !set.IsKnown() || !set.CanIterateElements() {
+		return false
+	}
+	for it := set.ElementIterator(); it.Next(); {
+		_, elem := it.Element()
+		if elem.IsNull() || !elem.Type().IsObjectType() || !elem.Type().HasAttribute("name") || !elem.Type().HasAttribute("append_newline") {
+			continue
+		}
+		n := elem.GetAttr("name")
+		if n.IsNull() || !n.IsKnown() || n.AsString() != name {
+			continue
+		}
+		return elem.GetAttr("append_newline").IsNull()
+	}
+	return false
+}
@@ -0,0 +1,399 @@
+package fastly
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestLoggingFormat(t *testing.T) {
+	for _, c := range []struct {
+		name     string
+		data     map[string]interface{}
+		format   string
+		expected string
+	}{
+		{
+			name:     "append_newline enabled",
+			data:     map[string]interface{}{"append_newline": true},
+			format:   "%h %l %u",
+			expected: "%h %l %u\n",
+		},
+		{
+			name:     "format already ends with a newline",
+			data:     map[string]interface{}{"append_newline": true},
+			format:   "%h %l %u\n",
+			expected: "%h %l %u\n",
+		},
+		{
+			name:     "empty format",
+			data:     map[string]interface{}{"append_newline": true},
+			format:   "",
+			expected: "",
+		},
+		{
+			name:     "append_newline disabled",
+			data:     map[string]interface{}{"append_newline": false},
+			format:   "%h %l %u",
+			expected: "%h %l %u",
+		},
+		{
+			name:     "append_newline unset",
+			data:     map[string]interface{}{},
+			format:   "%h %l %u",
+			expected: "%h %l %u",
+		},
+	} {
+		t.Run(c.name, func(t *testing.T) {
+			if got := loggingFormat(c.data, c.format); got != c.expected {
+				t.Fatalf("expected %q, got %q", c.expected, got)
+			}
+		})
+	}
+}
+
+func TestModifiedLoggingFormat(t *testing.T) {
+	h := &DefaultServiceAttributeHandler{key: "logging_syslog", serviceMetadata: ServiceMetadata{ServiceTypeVCL}}
+	resource := map[string]interface{}{"name": "syslog", "format": "%h", "append_newline": true}
+
+	if v := h.modifiedLoggingFormat(resource, map[string]interface{}{"port": 514}); v != nil {
+		t.Fatalf("expected no format when neither format nor append_newline changed, got %q", *v)
+	}
+	if v := h.modifiedLoggingFormat(resource, map[string]interface{}{"format": "%h"}); v == nil || *v != "%h\n" {
+		t.Fatalf("expected format with newline when format changed, got %v", v)
+	}
+	resource["append_newline"] = false
+	if v := h.modifiedLoggingFormat(resource, map[string]interface{}{"append_newline": false}); v == nil || *v != "%h" {
+		t.Fatalf("expected format without newline when append_newline changed, got %v", v)
+	}
+}
+
+func TestRestoreLoggingFormat(t *testing.T) {
+	for _, c := range []struct {
+		name     string
+		key      string
+		state    []interface{}
+		remote   map[string]interface{}
+		expected map[string]interface{}
+	}{
+		{
+			name:     "newline appended by the provider",
+			state:    []interface{}{map[string]interface{}{"name": "syslog", "address": "example.com", "format": "%h", "append_newline": true}},
+			remote:   map[string]interface{}{"name": "syslog", "format": "%h\n"},
+			expected: map[string]interface{}{"name": "syslog", "format": "%h", "append_newline": true},
+		},
+		{
+			name:     "format changed outside of Terraform",
+			state:    []interface{}{map[string]interface{}{"name": "syslog", "address": "example.com", "format": "%h", "append_newline": true}},
+			remote:   map[string]interface{}{"name": "syslog", "format": "%h %l\n"},
+			expected: map[string]interface{}{"name": "syslog", "format": "%h %l\n", "append_newline": true},
+		},
+		{
+			name:     "append_newline disabled",
+			state:    []interface{}{map[string]interface{}{"name": "syslog", "address": "example.com", "format": "%h", "append_newline": false}},
+			remote:   map[string]interface{}{"name": "syslog", "format": "%h\n"},
+			expected: map[string]interface{}{"name": "syslog", "format": "%h\n", "append_newline": false},
+		},
+		{
+			name:     "no prior state",
+			remote:   map[string]interface{}{"name": "syslog", "format": "%h\n"},
+			expected: map[string]interface{}{"name": "syslog", "format": "%h\n", "append_newline": true},
+		},
+		{
+			name:     "no prior state without newline",
+			remote:   map[string]interface{}{"name": "syslog", "format": "%h"},
+			expected: map[string]interface{}{"name": "syslog", "format": "%h", "append_newline": false},
+		},
+		{
+			name:     "unset format filled in with the API default",
+			key:      "logging_datadog",
+			state:    []interface{}{map[string]interface{}{"name": "datadog", "token": "s3cr3t"}},
+			remote:   map[string]interface{}{"name": "datadog", "format": loggingDefaultFormat},
+			expected: map[string]interface{}{"name": "datadog", "append_newline": true},
+		},
+		{
+			name:     "unset format changed outside of Terraform",
+			key:      "logging_datadog",
+			state:    []interface{}{map[string]interface{}{"name": "datadog", "token": "s3cr3t"}},
+			remote:   map[string]interface{}{"name": "datadog", "format": "%h"},
+			expected: map[string]interface{}{"name": "datadog", "format": "%h", "append_newline": true},
+		},
+	} {
+		t.Run(c.name, func(t *testing.T) {
+			key := c.key
+			if key == "" {
+				key = "logging_syslog"
+			}
+			raw := map[string]interface{}{"name": "test"}
+			if c.state != nil {
+				raw[key] = c.state
+			}
+			d := schema.TestResourceDataRaw(t, resourceServiceVCL().Schema, raw)
+			h := &DefaultServiceAttributeHandler{key: key, serviceMetadata: ServiceMetadata{ServiceTypeVCL}}
+
+			h.restoreLoggingFormat(d, c.remote)
+			if diff := cmp.Diff(c.expected, c.remote); diff != "" {
+				t.Fatalf("Error matching: %s", diff)
+			}
+		})
+	}
+}
+
+func TestLoggingAppendNewlineUnset(t *testing.T) {
+	endpoint := func(name string, appendNewline cty.Value) cty.Value {
+		return cty.ObjectVal(map[string]cty.Value{"name": cty.StringVal(name), "append_newline": appendNewline})
+	}
+	state := cty.ObjectVal(map[string]cty.Value{
+		"logging_syslog": cty.SetVal([]cty.Value{
+			endpoint("upgraded", cty.NullVal(cty.Bool)),
+			endpoint("current", cty.False),
+		}),
+	})
+
+	for _, c := range []struct {
+		name     string
+		state    cty.Value
+		key      string
+		endpoint string
+		expected bool
+	}{
+		{"append_newline missing from state", state, "logging_syslog", "upgraded", true},
+		{"append_newline in state", state, "logging_syslog", "current", false},
+		{"endpoint not in state", state, "logging_syslog", "new", false},
+		{"block not in state", state, "logging_datadog", "upgraded", false},
+		{"no state", cty.NullVal(state.Type()), "logging_syslog", "upgraded", false},
+	} {
+		t.Run(c.name, func(t *testing.T) {
+			if got := loggingAppendNewlineUnset(c.state, c.key, c.endpoint); got != c.expected {
+				t.Fatalf("expected %t, got %t", c.expected, got)
+			}
+		})
+	}
+}
+
+func TestLoggingPlacementWarnings(t *testing.T) {
+	for _, c := range []struct {
+		name     string
+		raw      map[string]interface{}
+		expected int
+	}{
+		{
+			name: "waf_debug without waf",
+			raw: map[string]interface{}{
+				"logging_syslog": []interface{}{map[string]interface{}{"name": "debug", "address": "example.com", "placement": "waf_debug"}},
+			},
+			expected: 1,
+		},
+		{
+			name: "default placement waf_debug without waf",
+			raw: map[string]interface{}{
+				defaultLoggingPlacementKey: "waf_debug",
+				"logging_syslog":           []interface{}{map[string]interface{}{"name": "debug", "address": "example.com"}},
+			},
+			expected: 1,
+		},
+		{
+			name: "waf_debug with waf",
+			raw: map[string]interface{}{
+				"logging_syslog": []interface{}{map[string]interface{}{"name": "debug", "address": "example.com", "placement": "waf_debug"}},
+				"waf":            []interface{}{map[string]interface{}{"response_object": "WAF_Response"}},
+			},
+			expected: 0,
+		},
+		{
+			name: "other placement",
+			raw: map[string]interface{}{
+				"logging_syslog": []interface{}{map[string]interface{}{"name": "debug", "address": "example.com", "placement": "none"}},
+			},
+			expected: 0,
+		},
+	} {
+		t.Run(c.name, func(t *testing.T) {
+			c.raw["name"] = "test"
+			d := schema.TestResourceDataRaw(t, resourceServiceVCL().Schema, c.raw)
+			diags := loggingPlacementWarnings(d, vclService)
+			if len(diags) != c.expected {
+				t.Fatalf("expected %d warnings, got %d: %#v", c.expected, len(diags), diags)
+			}
+		})
+	}
+}
+
+func TestCheckLoggingCompression(t *testing.T) {
+	for _, c := range []struct {
+		name     string
+		resource map[string]interface{}
+		wantErr  bool
+	}{
+		{
+			name:     "neither set",
+			resource: map[string]interface{}{"name": "s3", "compression_codec": "", "gzip_level": 0},
+		},
+		{
+			name:     "compression_codec only",
+			resource: map[string]interface{}{"name": "s3", "compression_codec": "zstd", "gzip_level": 0},
+		},
+		{
+			name:     "gzip_level only",
+			resource: map[string]interface{}{"name": "s3", "compression_codec": "", "gzip_level": 5},
+		},
+		{
+			name:     "both set",
+			resource: map[string]interface{}{"name": "s3", "compression_codec": "gzip", "gzip_level": 5},
+			wantErr:  true,
+		},
+	} {
+		t.Run(c.name, func(t *testing.T) {
+			err := checkLoggingCompression(c.resource)
+			if c.wantErr && err == nil {
+				t.Fatal("expected an error")
+			}
+			if !c.wantErr && err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+		})
+	}
+}
+
+func TestPruneLoggingCompression(t *testing.T) {
+	data := map[string]interface{}{"name": "s3", "compression_codec": "gzip", "gzip_level": uint(3)}
+	pruneLoggingCompression(data)
+	if diff := cmp.Diff(map[string]interface{}{"name": "s3", "compression_codec": "gzip"}, data); diff != "" {
+		t.Fatalf("Error matching: %s", diff)
+	}
+
+	data = map[string]interface{}{"name": "s3", "gzip_level": uint(5)}
+	pruneLoggingCompression(data)
+	if diff := cmp.Diff(map[string]interface{}{"name": "s3", "gzip_level": uint(5)}, data); diff != "" {
+		t.Fatalf("Error matching: %s", diff)
+	}
+}
+
+func TestRestoreLoggingPlacement(t *testing.T) {
+	for _, c := range []struct {
+		name             string
+		defaultPlacement string
+		state            []interface{}
+		remote           map[string]interface{}
+		expected         map[string]interface{}
+	}{
+		{
+			name:             "placement from the service default",
+			defaultPlacement: "none",
+			state:            []interface{}{map[string]interface{}{"name": "syslog", "address": "example.com"}},
+			remote:           map[string]interface{}{"name": "syslog", "placement": "none"},
+			expected:         map[string]interface{}{"name": "syslog"},
+		},
+		{
+			name:             "placement set on the endpoint",
+			defaultPlacement: "none",
+			state:            []interface{}{map[string]interface{}{"name": "syslog", "address": "example.com", "placement": "none"}},
+			remote:           map[string]interface{}{"name": "syslog", "placement": "none"},
+			expected:         map[string]interface{}{"name": "syslog", "placement": "none"},
+		},
+		{
+			name:             "placement changed outside of Terraform",
+			defaultPlacement: "none",
+			state:            []interface{}{map[string]interface{}{"name": "syslog", "address": "example.com"}},
+			remote:           map[string]interface{}{"name": "syslog", "placement": "waf_debug"},
+			expected:         map[string]interface{}{"name": "syslog", "placement": "waf_debug"},
+		},
+		{
+			name:     "no service default",
+			state:    []interface{}{map[string]interface{}{"name": "syslog", "address": "example.com"}},
+			remote:   map[string]interface{}{"name": "syslog", "placement": "none"},
+			expected: map[string]interface{}{"name": "syslog", "placement": "none"},
+		},
+	} {
+		t.Run(c.name, func(t *testing.T) {
+			raw := map[string]interface{}{"name": "test", "logging_syslog": c.state}
+			if c.defaultPlacement != "" {
+				raw[defaultLoggingPlacementKey] = c.defaultPlacement
+			}
+			d := schema.TestResourceDataRaw(t, resourceServiceVCL().Schema, raw)
+			h := &DefaultServiceAttributeHandler{key: "logging_syslog", serviceMetadata: ServiceMetadata{ServiceTypeVCL}}
+
+			h.restoreLoggingPlacement(d, c.remote)
+			if diff := cmp.Diff(c.expected, c.remote); diff != "" {
+				t.Fatalf("Error matching: %s", diff)
+			}
+		})
+	}
+}
+
+func TestValidateLoggingEndpoints(t *testing.T) {
+	// unknown is how Terraform passes a value that isn't known until apply.
+	const unknown = "74D93920-ED26-11E3-AC10-0800200C9A66"
+
+	for name, testcase := range map[string]struct {
+		key         string
+		endpoint    map[string]interface{}
+		expectError bool
+	}{
+		"kafka without auth": {
+			key:      "logging_kafka",
+			endpoint: map[string]interface{}{"name": "kafka", "topic": "topic", "brokers": "broker:9092"},
+		},
+		"kafka auth with user and password": {
+			key:      "logging_kafka",
+			endpoint: map[string]interface{}{"name": "kafka", "topic": "topic", "brokers": "broker:9092", "auth_method": "plain", "user": "user", "password": "password"},
+		},
+		"kafka auth without password": {
+			key:         "logging_kafka",
+			endpoint:    map[string]interface{}{"name": "kafka", "topic": "topic", "brokers": "broker:9092", "auth_method": "plain", "user": "user"},
+			expectError: true,
+		},
+		"kafka auth with unknown password": {
+			key:      "logging_kafka",
+			endpoint: map[string]interface{}{"name": "kafka", "topic": "topic", "brokers": "broker:9092", "auth_method": "plain", "user": "user", "password": unknown},
+		},
+		"kafka client certificate and key": {
+			key:      "logging_kafka",
+			endpoint: map[string]interface{}{"name": "kafka", "topic": "topic", "brokers": "broker:9092", "tls_client_cert": "cert", "tls_client_key": "key"},
+		},
+		"kafka client certificate without key": {
+			key:         "logging_kafka",
+			endpoint:    map[string]interface{}{"name": "kafka", "topic": "topic", "brokers": "broker:9092", "tls_client_cert": "cert"},
+			expectError: true,
+		},
+		"sftp with password": {
+			key:      "logging_sftp",
+			endpoint: map[string]interface{}{"name": "sftp", "address": "sftp.example.com", "user": "user", "path": "/", "ssh_known_hosts": "sftp.example.com", "password": "password"},
+		},
+		"sftp without password or secret key": {
+			key:         "logging_sftp",
+			endpoint:    map[string]interface{}{"name": "sftp", "address": "sftp.example.com", "user": "user", "path": "/", "ssh_known_hosts": "sftp.example.com"},
+			expectError: true,
+		},
+		"kinesis with iam role": {
+			key:      "logging_kinesis",
+			endpoint: map[string]interface{}{"name": "kinesis", "topic": "topic", "iam_role": "arn:aws:iam::123456789012:role/kinesis"},
+		},
+		"kinesis with iam role and keys": {
+			key:         "logging_kinesis",
+			endpoint:    map[string]interface{}{"name": "kinesis", "topic": "topic", "iam_role": "arn:aws:iam::123456789012:role/kinesis", "access_key": "access", "secret_key": "secret"},
+			expectError: true,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			config := terraform.NewResourceConfigRaw(map[string]interface{}{
+				"name":       "test",
+				"domain":     []interface{}{map[string]interface{}{"name": "example.com"}},
+				testcase.key: []interface{}{testcase.endpoint},
+			})
+
+			// Diff runs the CustomizeDiff functions, as planning does.
+			_, err := resourceServiceVCL().Diff(context.Background(), nil, config, nil)
+			if testcase.expectError && err == nil {
+				t.Error("expected an error, got none")
+			}
+			if !testcase.expectError && err != nil {
+				t.Errorf("unexpected error: %s", err)
+			}
+		})
+	}
+}
@@ -3,6 +3,8 @@ package fastly
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strings"
 
 	gofastly "github.com/fastly/go-fastly/v6/fastly"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -53,6 +55,28 @@ type ServiceCRUDAttributeDefinition interface {
 	Delete(ctx context.Context, d *schema.ResourceData, resource map[string]interface{}, serviceVersion int, conn *gofastly.Client) error
 }
 
+// OrderedServiceCRUDAttributeDefinition can be implemented by a ServiceCRUDAttributeDefinition whose nested blocks must
+// be created and updated in a particular order, for example because the API validates references between them.
+// Without it, nested blocks are processed in the arbitrary order of the Terraform set.
+type OrderedServiceCRUDAttributeDefinition interface {
+	// Less reports whether the nested block a must be created or updated before the nested block b.
+	Less(a, b map[string]interface{}) bool
+}
+
+// RenamableServiceCRUDAttributeDefinition can be implemented by a ServiceCRUDAttributeDefinition whose nested blocks
+// hold data, such as dictionary items, that would be lost by deleting and re-creating them, and which the API can
+// rename in place. For every nested block added by a change for which IsRename reports true with a nested block
+// removed by it, Process calls Rename instead of Delete and Create.
+type RenamableServiceCRUDAttributeDefinition interface {
+	// IsRename reports whether the nested block oldResource can be renamed to the name of newResource, rather than
+	// being deleted and newResource created.
+	IsRename(oldResource, newResource map[string]interface{}) bool
+
+	// Rename should change the name of the nested block oldResource to the name of newResource, keeping its contents.
+	// See the description of Create for more details about the other arguments.
+	Rename(ctx context.Context, d *schema.ResourceData, oldResource, newResource map[string]interface{}, serviceVersion int, conn *gofastly.Client) error
+}
+
 // ToServiceAttributeDefinition returns an implementation of ServiceAttributeDefinition for a particular implementation
 // of ServiceCRUDAttributeDefinition. It implements the Process and Read methods from ServiceAttributeDefinition using
 // the SetDiff functions.
@@ -64,6 +88,18 @@ type blockSetAttributeHandler struct {
 	handler ServiceCRUDAttributeDefinition
 }
 
+// blockSchemas returns the schema of every nested block of serviceDef implemented with ToServiceAttributeDefinition,
+// by key.
+func blockSchemas(serviceDef ServiceDefinition) map[string]*schema.Schema {
+	schemas := map[string]*schema.Schema{}
+	for _, a := range serviceDef.GetAttributeHandler() {
+		if h, ok := a.(*blockSetAttributeHandler); ok {
+			schemas[h.handler.Key()] = h.handler.GetSchema()
+		}
+	}
+	return schemas
+}
+
 func (h *blockSetAttributeHandler) Register(s *schema.Resource) error {
 	s.Schema[h.handler.Key()] = h.handler.GetSchema()
 	return nil
@@ -98,6 +134,19 @@ func (h *blockSetAttributeHandler) Process(ctx context.Context, d *schema.Resour
 		return err
 	}
 
+	if o, ok := h.handler.(OrderedServiceCRUDAttributeDefinition); ok {
+		sortSetElements(diffResult.Added, o.Less)
+		sortSetElements(diffResult.Modified, o.Less)
+	}
+
+	if r, ok := h.handler.(RenamableServiceCRUDAttributeDefinition); ok {
+		for _, rename := range findRenames(r, diffResult) {
+			if err := r.Rename(ctx, d, rename[0], rename[1], serviceVersion, conn); err != nil {
+				return err
+			}
+		}
+	}
+
 	for _, resource := range diffResult.Deleted {
 		resource := resource.(map[string]interface{})
 		err := h.handler.Delete(ctx, d, resource, serviceVersion, conn)
@@ -106,8 +155,15 @@ func (h *blockSetAttributeHandler) Process(ctx context.Context, d *schema.Resour
 		}
 	}
 
+	defaultPlacement, defaultPlacementChanged := h.defaultLoggingPlacement(d)
+	processed := make(map[interface{}]bool)
+
 	for _, resource := range diffResult.Added {
 		resource := resource.(map[string]interface{})
+		processed[resource["name"]] = true
+		if defaultPlacement != "" && resource["placement"] == "" {
+			resource = copyWithPlacement(resource, defaultPlacement)
+		}
 		err := h.handler.Create(ctx, d, resource, serviceVersion, conn)
 		if err != nil {
 			return err
@@ -116,8 +172,12 @@ func (h *blockSetAttributeHandler) Process(ctx context.Context, d *schema.Resour
 
 	for _, resource := range diffResult.Modified {
 		resource := resource.(map[string]interface{})
+		processed[resource["name"]] = true
 
 		modified := setDiff.Filter(resource, oldSet)
+		if _, ok := modified["placement"]; (ok || defaultPlacementChanged) && resource["placement"] == "" {
+			modified["placement"] = defaultPlacement
+		}
 
 		err := h.handler.Update(ctx, d, resource, modified, serviceVersion, conn)
 		if err != nil {
@@ -125,13 +185,102 @@ func (h *blockSetAttributeHandler) Process(ctx context.Context, d *schema.Resour
 		}
 	}
 
+	// Logging endpoints that are otherwise unchanged pick up a changed default_logging_placement.
+	if defaultPlacementChanged {
+		for _, resource := range newSet.List() {
+			resource := resource.(map[string]interface{})
+			if processed[resource["name"]] || resource["placement"] != "" {
+				continue
+			}
+			modified := map[string]interface{}{"placement": defaultPlacement}
+			if err := h.handler.Update(ctx, d, resource, modified, serviceVersion, conn); err != nil {
+				return err
+			}
+		}
+	}
+
 	return nil
 }
 
 func (h *blockSetAttributeHandler) HasChange(d *schema.ResourceData) bool {
-	return d.HasChanges(h.handler.Key())
+	if d.HasChanges(h.handler.Key()) {
+		return true
+	}
+	_, changed := h.defaultLoggingPlacement(d)
+	return changed
+}
+
+// defaultLoggingPlacement returns the service's default_logging_placement if the nested blocks are logging endpoints
+// with a placement, and whether it changed in a way that affects any of them, i.e. whether any of them don't set their
+// own placement.
+func (h *blockSetAttributeHandler) defaultLoggingPlacement(d *schema.ResourceData) (string, bool) {
+	if !strings.HasPrefix(h.handler.Key(), "logging_") {
+		return "", false
+	}
+	elem, ok := h.handler.GetSchema().Elem.(*schema.Resource)
+	if !ok {
+		return "", false
+	}
+	if _, ok := elem.Schema["placement"]; !ok {
+		return "", false
+	}
+
+	defaultPlacement, _ := d.Get(defaultLoggingPlacementKey).(string)
+	if !d.HasChange(defaultLoggingPlacementKey) {
+		return defaultPlacement, false
+	}
+	if set, ok := d.Get(h.handler.Key()).(*schema.Set); ok {
+		for _, resource := range set.List() {
+			if resource.(map[string]interface{})["placement"] == "" {
+				return defaultPlacement, true
+			}
+		}
+	}
+	return defaultPlacement, false
+}
+
+// copyWithPlacement returns a copy of a nested block with its placement set, leaving the block held by the set as is.
+func copyWithPlacement(resource map[string]interface{}, placement string) map[string]interface{} {
+	c := make(map[string]interface{}, len(resource))
+	for k, v := range resource {
+		c[k] = v
+	}
+	c["placement"] = placement
+	return c
 }
 
 func (h *blockSetAttributeHandler) MustProcess(d *schema.ResourceData, _ bool) bool {
 	return h.HasChange(d)
 }
+
+// sortSetElements sorts the elements of a set, as returned by SetDiff, using the provided less function.
+func sortSetElements(elements []interface{}, less func(a, b map[string]interface{}) bool) {
+	sort.SliceStable(elements, func(i, j int) bool {
+		return less(elements[i].(map[string]interface{}), elements[j].(map[string]interface{}))
+	})
+}
+
+// findRenames returns the pairs of nested blocks removed and added by a change for which IsRename reports true, and
+// removes them from diffResult so that they are neither deleted nor created.
+func findRenames(r RenamableServiceCRUDAttributeDefinition, diffResult *DiffResult) [][2]map[string]interface{} {
+	var renames [][2]map[string]interface{}
+	var added []interface{}
+	for _, a := range diffResult.Added {
+		newResource := a.(map[string]interface{})
+		renamed := false
+		for i, d := range diffResult.Deleted {
+			oldResource := d.(map[string]interface{})
+			if r.IsRename(oldResource, newResource) {
+				renames = append(renames, [2]map[string]interface{}{oldResource, newResource})
+				diffResult.Deleted = append(diffResult.Deleted[:i:i], diffResult.Deleted[i+1:]...)
+				renamed = true
+				break
+			}
+		}
+		if !renamed {
+			added = append(added, a)
+		}
+	}
+	diffResult.Added = added
+	return renames
+}
@@ -1,8 +1,13 @@
 package fastly
 
 import (
+	"encoding/json"
 	"encoding/pem"
 	"fmt"
+	"net"
+	"net/url"
+	"regexp"
+	"strconv"
 	"strings"
 
 	gofastly "github.com/fastly/go-fastly/v6/fastly"
@@ -16,6 +21,105 @@ func validateLoggingFormatVersion() schema.SchemaValidateDiagFunc {
 	return validation.ToDiagFunc(validation.IntBetween(1, 2))
 }
 
+// loggingFormatDirectives are the characters that end an Apache-style log format directive, e.g. the "h" of "%h" or
+// the "V" of "%{req.http.host}V".
+const loggingFormatDirectives = "aAbBCDefhHiIlmnoOpPqrsStTuUvVX"
+
+// validateLoggingFormat returns a schema validation function that warns about a "%" in a log format that doesn't
+// start a directive. The Fastly API rejects such formats on apply, so a literal "%" must be escaped as "%%".
+func validateLoggingFormat() schema.SchemaValidateDiagFunc {
+	return validation.ToDiagFunc(func(val interface{}, key string) ([]string, []error) {
+		if i := invalidLoggingFormatDirective(val.(string)); i >= 0 {
+			return []string{fmt.Sprintf("%s has a %q at position %d that doesn't start a log format directive, which the Fastly API will reject. Use %q for a literal %q", key, "%", i, "%%", "%")}, nil
+		}
+		return nil, nil
+	})
+}
+
+// invalidLoggingFormatDirective returns the position of the first "%" in format that doesn't start a directive, or -1
+// if there is none. A directive is "%%", or "%" followed by optional status code conditions and "<" or ">" modifiers,
+// an optional "{...}" argument and one of loggingFormatDirectives.
+func invalidLoggingFormatDirective(format string) int {
+	for i := 0; i < len(format); i++ {
+		if format[i] != '%' {
+			continue
+		}
+		if i+1 < len(format) && format[i+1] == '%' {
+			i++
+			continue
+		}
+		j := loggingFormatDirectiveEnd(format, i)
+		if j < 0 {
+			return i
+		}
+		i = j
+	}
+	return -1
+}
+
+// loggingFormatDirectiveEnd returns the position of the directive character, e.g. the "V" of "%{req.url}V", of the
+// directive that starts with the "%" at position i of format, or -1 if it isn't a valid directive.
+func loggingFormatDirectiveEnd(format string, i int) int {
+	j := i + 1
+	for j < len(format) && strings.IndexByte("!,0123456789<>", format[j]) >= 0 {
+		j++
+	}
+	if j < len(format) && format[j] == '{' {
+		// VCL arguments may contain braces themselves, e.g. %{strftime({"%Y"}, time.start)}V.
+		depth := 0
+		for ; j < len(format); j++ {
+			if format[j] == '{' {
+				depth++
+			} else if format[j] == '}' {
+				depth--
+				if depth == 0 {
+					break
+				}
+			}
+		}
+		if depth != 0 {
+			return -1
+		}
+		j++
+	}
+	if j >= len(format) || strings.IndexByte(loggingFormatDirectives, format[j]) < 0 {
+		return -1
+	}
+	return j
+}
+
+// validateLoggingJSONFormat returns a schema validation function that warns when a log format for an endpoint that
+// ingests JSON doesn't produce a JSON object. Each directive is replaced with "0", which is valid JSON both inside a
+// string and as a bare number or boolean expression, so this only catches errors in the JSON around the directives.
+func validateLoggingJSONFormat() schema.SchemaValidateDiagFunc {
+	return validation.ToDiagFunc(func(val interface{}, key string) ([]string, []error) {
+		format := val.(string)
+		if format == "" || invalidLoggingFormatDirective(format) >= 0 {
+			return nil, nil
+		}
+
+		var placeholder strings.Builder
+		for i := 0; i < len(format); i++ {
+			switch {
+			case format[i] != '%':
+				placeholder.WriteByte(format[i])
+			case format[i+1] == '%':
+				placeholder.WriteByte('%')
+				i++
+			default:
+				placeholder.WriteByte('0')
+				i = loggingFormatDirectiveEnd(format, i)
+			}
+		}
+
+		var object map[string]interface{}
+		if err := json.Unmarshal([]byte(placeholder.String()), &object); err != nil {
+			return []string{fmt.Sprintf("%s doesn't produce a JSON object, which this logging endpoint requires: %s", key, err)}, nil
+		}
+		return nil, nil
+	})
+}
+
 func validateLoggingMessageType() schema.SchemaValidateDiagFunc {
 	return validation.ToDiagFunc(validation.StringInSlice([]string{
 		"classic",
@@ -33,6 +137,18 @@ func validateLoggingCompressionCodec() schema.SchemaValidateDiagFunc {
 	}, false))
 }
 
+func validateLoggingGzipLevel() schema.SchemaValidateDiagFunc {
+	return validation.ToDiagFunc(validation.IntBetween(0, 9))
+}
+
+func validateLoggingPeriod() schema.SchemaValidateDiagFunc {
+	return validation.ToDiagFunc(validation.IntAtLeast(1))
+}
+
+func validateLoggingRequestLimit() schema.SchemaValidateDiagFunc {
+	return validation.ToDiagFunc(validation.IntAtLeast(0))
+}
+
 func validateLoggingPlacement() schema.SchemaValidateDiagFunc {
 	return validation.ToDiagFunc(validation.StringInSlice([]string{
 		"none",
@@ -40,6 +156,39 @@ func validateLoggingPlacement() schema.SchemaValidateDiagFunc {
 	}, false))
 }
 
+func validateLoggingDatadogRegion() schema.SchemaValidateDiagFunc {
+	return validation.ToDiagFunc(validation.StringInSlice([]string{
+		"US",
+		"EU",
+	}, false))
+}
+
+func validateLoggingScalyrRegion() schema.SchemaValidateDiagFunc {
+	return validation.ToDiagFunc(validation.StringInSlice([]string{
+		"US",
+		"EU",
+	}, false))
+}
+
+func validateLoggingNewRelicOTLPRegion() schema.SchemaValidateDiagFunc {
+	return validation.ToDiagFunc(validation.StringInSlice([]string{
+		"US",
+		"EU",
+	}, false))
+}
+
+func validateLoggingLogentriesRegion() schema.SchemaValidateDiagFunc {
+	return validation.ToDiagFunc(validation.StringInSlice([]string{
+		"US",
+		"US-2",
+		"US-3",
+		"EU",
+		"CA",
+		"AU",
+		"AP",
+	}, false))
+}
+
 func validateLoggingServerSideEncryption() schema.SchemaValidateDiagFunc {
 	return validation.ToDiagFunc(validation.StringInSlice([]string{
 		string(gofastly.S3ServerSideEncryptionAES),
@@ -47,14 +196,158 @@ func validateLoggingServerSideEncryption() schema.SchemaValidateDiagFunc {
 	}, false))
 }
 
+// validateLoggingHerokuURL returns a schema validation function that checks a Heroku Logplex URL. The URL embeds the
+// token, so the value is never included in the errors.
+func validateLoggingHerokuURL() schema.SchemaValidateDiagFunc {
+	return validation.ToDiagFunc(func(val interface{}, key string) ([]string, []error) {
+		u, err := url.Parse(val.(string))
+		if err != nil {
+			return nil, []error{fmt.Errorf("expected %s to be a valid URL", key)}
+		}
+		if u.Scheme != "https" {
+			return nil, []error{fmt.Errorf("expected %s to use the https scheme", key)}
+		}
+		if u.Host == "" {
+			return nil, []error{fmt.Errorf("expected %s to have a host", key)}
+		}
+		return nil, nil
+	})
+}
+
+// validateLoggingSumologicURL returns a schema validation function that checks a Sumo Logic HTTP source URL, such as
+// https://endpoint1.collection.eu.sumologic.com/receiver/v1/http/<code>. The Sumo Logic deployment, e.g. EU, is part
+// of the host and the collector code is part of the path. Either being wrong only shows up as logs not arriving, so
+// those are warned about. The URL embeds the collector code, so the value is never included in the diagnostics.
+func validateLoggingSumologicURL() schema.SchemaValidateDiagFunc {
+	return validation.ToDiagFunc(func(val interface{}, key string) ([]string, []error) {
+		u, err := url.Parse(val.(string))
+		if err != nil {
+			return nil, []error{fmt.Errorf("expected %s to be a valid URL", key)}
+		}
+		if u.Scheme != "https" {
+			return nil, []error{fmt.Errorf("expected %s to use the https scheme", key)}
+		}
+		if u.Host == "" {
+			return nil, []error{fmt.Errorf("expected %s to have a host", key)}
+		}
+
+		var warnings []string
+		if !strings.HasSuffix(u.Hostname(), ".sumologic.com") {
+			warnings = append(warnings, fmt.Sprintf("%s host %q isn't a Sumo Logic collection endpoint. Use the URL of the HTTP source from Sumo Logic, which includes the deployment, e.g. endpoint1.collection.eu.sumologic.com", key, u.Hostname()))
+		}
+		if code := strings.TrimPrefix(u.Path, "/receiver/v1/http/"); code == u.Path || code == "" {
+			warnings = append(warnings, fmt.Sprintf("%s doesn't have a /receiver/v1/http/ path with the collector code. Use the URL of the HTTP source from Sumo Logic", key))
+		}
+		return warnings, nil
+	})
+}
+
+// validateLoggingDigitalOceanDomain returns a schema validation function that checks a DigitalOcean Spaces endpoint is
+// a bare host such as sfo3.digitaloceanspaces.com. Fastly builds the request URL from the domain and the bucket name,
+// so a scheme, a path or the bucket name in the domain makes the endpoint unreachable.
+func validateLoggingDigitalOceanDomain() schema.SchemaValidateDiagFunc {
+	return validation.ToDiagFunc(func(i interface{}, k string) (s []string, es []error) {
+		v, ok := i.(string)
+		if !ok {
+			es = append(es, fmt.Errorf("expected type of %s to be string", k))
+			return
+		}
+
+		if strings.Contains(v, "://") {
+			es = append(es, fmt.Errorf("expected %s (%q) to be a host without a scheme, e.g. sfo3.digitaloceanspaces.com", k, v))
+			return
+		}
+		if strings.Contains(v, "/") {
+			es = append(es, fmt.Errorf("expected %s (%q) to be a host without a path, e.g. sfo3.digitaloceanspaces.com", k, v))
+			return
+		}
+		if strings.HasSuffix(v, ".digitaloceanspaces.com") && strings.Count(v, ".") > 2 {
+			s = append(s, fmt.Sprintf("%s (%q) looks like it includes the bucket name. It should be the regional endpoint, e.g. sfo3.digitaloceanspaces.com, with the bucket set in bucket_name", k, v))
+		}
+
+		return
+	})
+}
+
 func validateDirectorQuorum() schema.SchemaValidateDiagFunc {
 	return validation.ToDiagFunc(validation.IntBetween(0, 100))
 }
 
+// serviceCommentMaxLength is the longest service or version comment the Fastly
+// API stores without truncating it.
+const serviceCommentMaxLength = 255
+
+func validateServiceComment() schema.SchemaValidateDiagFunc {
+	return validation.ToDiagFunc(validation.StringLenBetween(0, serviceCommentMaxLength))
+}
+
+func validateServiceDrainTimeout() schema.SchemaValidateDiagFunc {
+	return validation.ToDiagFunc(validation.IntAtLeast(0))
+}
+
+func validateServiceVersion() schema.SchemaValidateDiagFunc {
+	return validation.ToDiagFunc(validation.IntAtLeast(1))
+}
+
+func validatePortNumber() schema.SchemaValidateDiagFunc {
+	return validation.ToDiagFunc(validation.IsPortNumber)
+}
+
+func validateLoggingHTTPSMethod() schema.SchemaValidateDiagFunc {
+	return validation.ToDiagFunc(validation.StringInSlice([]string{"POST", "PUT"}, false))
+}
+
+func validateLoggingHTTPSJSONFormat() schema.SchemaValidateDiagFunc {
+	return validation.ToDiagFunc(validation.StringInSlice([]string{"0", "1", "2"}, false))
+}
+
+func validateRequestSettingMaxStaleAge() schema.SchemaValidateDiagFunc {
+	return validation.ToDiagFunc(validation.IntAtLeast(0))
+}
+
+func validateRequestSettingAction() schema.SchemaValidateDiagFunc {
+	return validation.ToDiagFunc(validation.StringInSlice([]string{
+		string(gofastly.RequestSettingActionLookup),
+		string(gofastly.RequestSettingActionPass),
+	}, false))
+}
+
+func validateRequestSettingXFF() schema.SchemaValidateDiagFunc {
+	return validation.ToDiagFunc(validation.StringInSlice([]string{
+		string(gofastly.RequestSettingXFFClear),
+		string(gofastly.RequestSettingXFFLeave),
+		string(gofastly.RequestSettingXFFAppend),
+		string(gofastly.RequestSettingXFFAppendAll),
+		string(gofastly.RequestSettingXFFOverwrite),
+	}, false))
+}
+
+// validateTTL returns a schema validation function for a time-to-live in seconds. The API takes an unsigned integer,
+// so a negative value would otherwise wrap around to a huge TTL when it is converted.
+func validateTTL() schema.SchemaValidateDiagFunc {
+	return validation.ToDiagFunc(validation.IntAtLeast(0))
+}
+
+func validateDirectorCapacity() schema.SchemaValidateDiagFunc {
+	return validation.ToDiagFunc(validation.IntAtLeast(0))
+}
+
 func validateDirectorType() schema.SchemaValidateDiagFunc {
 	return validation.ToDiagFunc(validation.IntInSlice([]int{1, 3, 4}))
 }
 
+func validateResponseObjectStatus() schema.SchemaValidateDiagFunc {
+	return validation.ToDiagFunc(validation.IntBetween(100, 599))
+}
+
+func validateCacheSettingAction() schema.SchemaValidateDiagFunc {
+	return validation.ToDiagFunc(validation.StringInSlice([]string{
+		string(gofastly.CacheSettingActionCache),
+		string(gofastly.CacheSettingActionPass),
+		string(gofastly.CacheSettingActionRestart),
+	}, false))
+}
+
 func validateConditionType() schema.SchemaValidateDiagFunc {
 	return validation.ToDiagFunc(validation.StringInSlice([]string{
 		"REQUEST",
@@ -83,6 +376,36 @@ func validateHeaderType() schema.SchemaValidateDiagFunc {
 	}, false))
 }
 
+var (
+	headerDestinationHTTPRegexp     = regexp.MustCompile("^http\\.[!#$%&'*+.^_`|~0-9A-Za-z-]+$")
+	headerDestinationVariableRegexp = regexp.MustCompile(`^[a-z_]+(\.[a-z0-9_]+)*$`)
+)
+
+// validateHeaderDestination returns a schema validation function that warns when a header's destination doesn't look
+// like something a header rule can write to: either an HTTP header, written as `http.Header-Name`, or a VCL variable
+// such as `url` or `client.geo.ip_override`. Fastly accepts any destination and a mistyped one silently never fires, so
+// this only warns rather than trying to enumerate every writable VCL variable.
+func validateHeaderDestination() schema.SchemaValidateDiagFunc {
+	return validation.ToDiagFunc(func(i interface{}, k string) (s []string, es []error) {
+		v, ok := i.(string)
+		if !ok {
+			es = append(es, fmt.Errorf("expected type of %s to be string", k))
+			return
+		}
+
+		switch {
+		case strings.HasPrefix(v, "http."):
+			if !headerDestinationHTTPRegexp.MatchString(v) {
+				s = append(s, fmt.Sprintf("%s %q isn't a valid HTTP header name after the `http.` prefix", k, v))
+			}
+		case !headerDestinationVariableRegexp.MatchString(v):
+			s = append(s, fmt.Sprintf("%s %q doesn't look like a VCL variable. HTTP headers must be written as `http.Header-Name`, e.g. %q", k, v, "http."+strings.TrimSpace(v)))
+		}
+
+		return
+	})
+}
+
 func validateSnippetType() schema.SchemaValidateDiagFunc {
 	return validation.ToDiagFunc(validation.StringInSlice([]string{
 		"init",
@@ -127,6 +450,102 @@ func validateDictionaryItems() schema.SchemaValidateDiagFunc {
 	})
 }
 
+func validateKafkaCompressionCodec() schema.SchemaValidateDiagFunc {
+	return validation.ToDiagFunc(validation.StringInSlice([]string{
+		"gzip",
+		"snappy",
+		"lz4",
+	}, false))
+}
+
+func validateKafkaRequiredAcks() schema.SchemaValidateDiagFunc {
+	return validation.ToDiagFunc(validation.StringInSlice([]string{
+		"1",
+		"0",
+		"-1",
+	}, false))
+}
+
+func validateKafkaAuthMethod() schema.SchemaValidateDiagFunc {
+	return validation.ToDiagFunc(validation.StringInSlice([]string{
+		"plain",
+		"scram-sha-256",
+		"scram-sha-512",
+	}, false))
+}
+
+// validateKafkaBrokers returns a schema validation function that checks whether every entry of a comma-separated list
+// of Kafka brokers is in the form host:port.
+func validateKafkaBrokers() schema.SchemaValidateDiagFunc {
+	return validation.ToDiagFunc(func(i interface{}, k string) (s []string, es []error) {
+		v, ok := i.(string)
+		if !ok {
+			es = append(es, fmt.Errorf("expected type of %s to be string", k))
+			return
+		}
+
+		for _, broker := range strings.Split(v, ",") {
+			host, port, err := net.SplitHostPort(strings.TrimSpace(broker))
+			if err != nil {
+				es = append(es, fmt.Errorf("expected each entry of %s to be in the form host:port, got %q: %s", k, broker, err))
+				continue
+			}
+			if host == "" {
+				es = append(es, fmt.Errorf("expected each entry of %s to have a host, got %q", k, broker))
+			}
+			if p, err := strconv.Atoi(port); err != nil || p < 1 || p > 65535 {
+				es = append(es, fmt.Errorf("expected each entry of %s to have a port between 1 and 65535, got %q", k, broker))
+			}
+		}
+
+		return
+	})
+}
+
+// validateRequestSettingHashKeys returns a schema validation function that checks whether a request setting's
+// hash_keys is a non-empty, comma-separated list without empty entries.
+func validateRequestSettingHashKeys() schema.SchemaValidateDiagFunc {
+	return validation.ToDiagFunc(func(i interface{}, k string) (s []string, es []error) {
+		v, ok := i.(string)
+		if !ok {
+			es = append(es, fmt.Errorf("expected type of %s to be string", k))
+			return
+		}
+
+		if strings.TrimSpace(v) == "" {
+			es = append(es, fmt.Errorf("expected %s to not be empty, got %q", k, v))
+			return
+		}
+		for _, key := range strings.Split(v, ",") {
+			if strings.TrimSpace(key) == "" {
+				es = append(es, fmt.Errorf("expected each entry of %s to not be empty, got %q", k, v))
+				return
+			}
+		}
+
+		return
+	})
+}
+
+// validateSSLCertHostname returns a schema validation function that warns when a backend's ssl_cert_hostname is
+// itself a wildcard. The value is sent to Fastly verbatim and matched against the origin certificate's names, so it
+// should be the concrete hostname the certificate is expected to cover.
+func validateSSLCertHostname() schema.SchemaValidateDiagFunc {
+	return validation.ToDiagFunc(func(i interface{}, k string) (s []string, es []error) {
+		v, ok := i.(string)
+		if !ok {
+			es = append(es, fmt.Errorf("expected type of %s to be string", k))
+			return
+		}
+
+		if strings.Contains(v, "*") {
+			s = append(s, fmt.Sprintf("%s (%q) contains a wildcard. It should be the specific hostname to verify the origin certificate against: a wildcard certificate for *.example.com matches www.example.com, but neither example.com nor a.b.example.com", k, v))
+		}
+
+		return
+	})
+}
+
 func validateUserRole() schema.SchemaValidateDiagFunc {
 	return validation.ToDiagFunc(validation.StringInSlice(
 		[]string{
@@ -139,6 +558,18 @@ func validateUserRole() schema.SchemaValidateDiagFunc {
 	))
 }
 
+func validateServiceAuthorizationPermission() schema.SchemaValidateDiagFunc {
+	return validation.ToDiagFunc(validation.StringInSlice(
+		[]string{
+			"full",
+			"read_only",
+			"purge_select",
+			"purge_all",
+		},
+		false,
+	))
+}
+
 // validatePEMBlock returns a schema validation function that checks whether a string contains a single PEM block of
 // type `pemType`.
 func validatePEMBlock(pemType string) schema.SchemaValidateDiagFunc {
@@ -183,6 +614,75 @@ func validatePEMBlocks(pemType string) schema.SchemaValidateDiagFunc {
 	})
 }
 
+// validateBackendSSLCACert returns a schema validation function that checks a backend CA certificate, which may be a
+// chain of PEM-format certificates. An empty value is allowed, for removing the CA certificate.
+func validateBackendSSLCACert() schema.SchemaValidateDiagFunc {
+	validatePEM := validatePEMBlocks("CERTIFICATE")
+	return func(i interface{}, path cty.Path) diag.Diagnostics {
+		if i.(string) == "" {
+			return nil
+		}
+		return validatePEM(i, path)
+	}
+}
+
+// validateGCPSecretKey returns a schema validation function that checks a Google Cloud Platform secret key. The key
+// is usually the private key alone, but when the service account JSON is pasted in instead it must parse and contain
+// the `client_email` and `private_key` fields. The value is sensitive, so it is never included in the errors.
+func validateGCPSecretKey() schema.SchemaValidateDiagFunc {
+	return validation.ToDiagFunc(func(val interface{}, key string) ([]string, []error) {
+		v := strings.TrimSpace(val.(string))
+		if !strings.HasPrefix(v, "{") {
+			return nil, nil
+		}
+
+		var credentials map[string]interface{}
+		if err := json.Unmarshal([]byte(v), &credentials); err != nil {
+			return nil, []error{fmt.Errorf("expected %s to be valid service account JSON", key)}
+		}
+
+		var errs []error
+		for _, field := range []string{"client_email", "private_key"} {
+			if s, _ := credentials[field].(string); s == "" {
+				errs = append(errs, fmt.Errorf("expected %s service account JSON to contain %q", key, field))
+			}
+		}
+		return nil, errs
+	})
+}
+
+// validateTLSProtocolFilter returns a schema validation function that warns when a TLS configuration protocol filter
+// isn't one of the known values, as Fastly may have added protocols (e.g. http/3) since they were listed. Such a
+// filter still only matches configurations the API reports the protocol for.
+func validateTLSProtocolFilter(known []string) schema.SchemaValidateDiagFunc {
+	return validation.ToDiagFunc(func(i interface{}, k string) (s []string, es []error) {
+		v, ok := i.(string)
+		if !ok {
+			es = append(es, fmt.Errorf("expected type of %s to be string", k))
+			return
+		}
+
+		for _, p := range known {
+			if v == p {
+				return
+			}
+		}
+		s = append(s, fmt.Sprintf("%s %q isn't a known protocol, expected one of %s", k, v, strings.Join(known, ", ")))
+		return
+	})
+}
+
+// validateAll returns a schema validation function that runs all of the given validation functions.
+func validateAll(validators ...schema.SchemaValidateDiagFunc) schema.SchemaValidateDiagFunc {
+	return func(i interface{}, path cty.Path) diag.Diagnostics {
+		var diags diag.Diagnostics
+		for _, validator := range validators {
+			diags = append(diags, validator(i, path)...)
+		}
+		return diags
+	}
+}
+
 func validateStringTrimmed(i interface{}, path cty.Path) diag.Diagnostics {
 	v := i.(string)
 	attr := path[len(path)-1].(cty.GetAttrStep)